@@ -0,0 +1,73 @@
+package gofp
+
+import "github.com/rbrahul/gofp/generic"
+
+// groupItems returns the items grouped by the key returned from the function, preserving their order
+func groupItems(items []interface{}, fn func(item interface{}) string) map[string][]interface{} {
+	groups := map[string][]interface{}{}
+	for _, item := range items {
+		key := fn(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
+// CountBy returns a map of the number of items per key returned from the function
+func CountBy(items []interface{}, fn func(item interface{}) string) map[string]int {
+	counts := map[string]int{}
+	for _, item := range items {
+		counts[fn(item)]++
+	}
+	return counts
+}
+
+// SumBy returns a map of the sum of the values returned from valueFn per key returned from keyFn
+func SumBy(items []interface{}, keyFn func(item interface{}) string, valueFn func(item interface{}) float64) map[string]float64 {
+	sums := map[string]float64{}
+	for _, item := range items {
+		sums[keyFn(item)] += valueFn(item)
+	}
+	return sums
+}
+
+// AverageBy returns a map of the mean of the values returned from valueFn per key returned from keyFn
+func AverageBy(items []interface{}, keyFn func(item interface{}) string, valueFn func(item interface{}) float64) map[string]float64 {
+	return generic.AverageBy(items, keyFn, valueFn)
+}
+
+// GroupMinBy returns a map of the item with the smallest value returned from valueFn per key returned from keyFn
+func GroupMinBy(items []interface{}, keyFn func(item interface{}) string, valueFn func(item interface{}) interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, group := range groupItems(items, keyFn) {
+		result[key] = MinBy(group, valueFn)
+	}
+	return result
+}
+
+// GroupMaxBy returns a map of the item with the largest value returned from valueFn per key returned from keyFn
+func GroupMaxBy(items []interface{}, keyFn func(item interface{}) string, valueFn func(item interface{}) interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, group := range groupItems(items, keyFn) {
+		result[key] = MaxBy(group, valueFn)
+	}
+	return result
+}
+
+// KeyBy returns a map of items indexed by the key returned from the function, later items win when keys repeat
+func KeyBy(items []interface{}, fn func(item interface{}) string) map[string]interface{} {
+	indexed := map[string]interface{}{}
+	for _, item := range items {
+		indexed[fn(item)] = item
+	}
+	return indexed
+}
+
+// AggregateBy groups the items by the key returned from keyFn and reduces every group with fn like Reduce does,
+// the group is passed to fn as the source
+func AggregateBy(items []interface{}, keyFn func(item interface{}) string, fn func(index int, current interface{}, accumulator interface{}, source []interface{}) interface{}, initialValue interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, group := range groupItems(items, keyFn) {
+		result[key] = Reduce(group, fn, initialValue)
+	}
+	return result
+}
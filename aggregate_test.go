@@ -0,0 +1,81 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+var sales = []interface{}{
+	map[string]interface{}{"region": "eu", "product": "book", "amount": 10.0},
+	map[string]interface{}{"region": "us", "product": "pen", "amount": 2.0},
+	map[string]interface{}{"region": "eu", "product": "pen", "amount": 4.0},
+	map[string]interface{}{"region": "eu", "product": "lamp", "amount": 25.0},
+}
+
+func region(item interface{}) string {
+	return item.(map[string]interface{})["region"].(string)
+}
+
+func amount(item interface{}) float64 {
+	return item.(map[string]interface{})["amount"].(float64)
+}
+
+func Test_CountBy(t *testing.T) {
+	counts := CountBy(sales, region)
+	if want := map[string]int{"eu": 3, "us": 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("CountBy() = %v, want %v", counts, want)
+	}
+}
+
+func Test_SumBy(t *testing.T) {
+	sums := SumBy(sales, region, amount)
+	if want := map[string]float64{"eu": 39, "us": 2}; !reflect.DeepEqual(sums, want) {
+		t.Errorf("SumBy() = %v, want %v", sums, want)
+	}
+}
+
+func Test_AverageBy(t *testing.T) {
+	averages := AverageBy(sales, region, amount)
+	if want := map[string]float64{"eu": 13, "us": 2}; !reflect.DeepEqual(averages, want) {
+		t.Errorf("AverageBy() = %v, want %v", averages, want)
+	}
+	calls := 0
+	AverageBy(sales, func(item interface{}) string {
+		calls++
+		return region(item)
+	}, amount)
+	if calls != len(sales) {
+		t.Errorf("AverageBy() called keyFn %v times, want %v", calls, len(sales))
+	}
+}
+
+func Test_GroupMinMaxBy(t *testing.T) {
+	value := func(item interface{}) interface{} { return amount(item) }
+	cheapest := GroupMinBy(sales, region, value)
+	if !reflect.DeepEqual(cheapest, map[string]interface{}{"eu": sales[2], "us": sales[1]}) {
+		t.Errorf("GroupMinBy() = %v, want %v", cheapest, map[string]interface{}{"eu": sales[2], "us": sales[1]})
+	}
+	priciest := GroupMaxBy(sales, region, value)
+	if !reflect.DeepEqual(priciest["eu"], sales[3]) {
+		t.Errorf("GroupMaxBy() = %v, want %v", priciest["eu"], sales[3])
+	}
+}
+
+func Test_KeyBy(t *testing.T) {
+	indexed := KeyBy(sales, func(item interface{}) string {
+		return item.(map[string]interface{})["product"].(string)
+	})
+	if len(indexed) != 3 || !reflect.DeepEqual(indexed["pen"], sales[2]) {
+		t.Errorf("KeyBy() = %v, want %v for pen", indexed["pen"], sales[2])
+	}
+}
+
+func Test_AggregateBy(t *testing.T) {
+	products := AggregateBy(sales, region, func(_ int, current interface{}, accumulator interface{}, _ []interface{}) interface{} {
+		return append(accumulator.([]string), current.(map[string]interface{})["product"].(string))
+	}, []string{})
+	want := map[string]interface{}{"eu": []string{"book", "pen", "lamp"}, "us": []string{"pen"}}
+	if !reflect.DeepEqual(products, want) {
+		t.Errorf("AggregateBy() = %v, want %v", products, want)
+	}
+}
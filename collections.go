@@ -1,5 +1,11 @@
 package gofp
 
+import (
+	"reflect"
+
+	"github.com/rbrahul/gofp/generic"
+)
+
 // Map returns a new slice with transformed elements
 func Map(items []interface{}, fn func(index int, item interface{}) interface{}) []interface{} {
 	mappedItems := []interface{}{}
@@ -9,7 +15,8 @@ func Map(items []interface{}, fn func(index int, item interface{}) interface{})
 	return mappedItems
 }
 
-//Fill substitutes the elements of slice with given string from the start to end position
+// Fill substitutes the elements of slice with given string from the start to end position.
+// FillWith offers the same with a filler of any type and without type assertions
 func Fill(args ...interface{}) []interface{} {
 	var (
 		filler string
@@ -50,6 +57,59 @@ func Fill(args ...interface{}) []interface{} {
 	return newItems
 }
 
+// FillOption selects the range of items replaced by FillWith
+type FillOption func(*fillRange)
+
+type fillRange struct {
+	start    int
+	end      int
+	hasStart bool
+	hasEnd   bool
+}
+
+// FillFrom sets the index of the first item to replace, a negative index counts from the end
+func FillFrom(start int) FillOption {
+	return func(r *fillRange) {
+		r.start, r.hasStart = start, true
+	}
+}
+
+// FillUntil sets the index before which replacing stops, a negative index counts from the end
+func FillUntil(end int) FillOption {
+	return func(r *fillRange) {
+		r.end, r.hasEnd = end, true
+	}
+}
+
+// FillWith returns a new slice where the items are replaced by filler, all of them unless FillFrom or FillUntil narrow the range
+func FillWith(items []interface{}, filler interface{}, opts ...FillOption) []interface{} {
+	r := fillRange{}
+	for _, opt := range opts {
+		opt(&r)
+	}
+	start, end := 0, len(items)
+	if r.hasStart {
+		start = r.start
+		if start < 0 {
+			start += len(items)
+		}
+	}
+	if r.hasEnd {
+		end = r.end
+		if end < 0 {
+			end += len(items)
+		}
+	}
+	newItems := make([]interface{}, len(items))
+	for index, value := range items {
+		if index >= start && index < end {
+			value = filler
+		}
+		newItems[index] = value
+	}
+	return newItems
+}
+
 // Filter returns a new slice of items which satisfies the condition
 func Filter(items []interface{}, fn func(index int, item interface{}) bool) []interface{} {
 	filteredItems := []interface{}{}
@@ -140,25 +200,12 @@ func Reverse(items []interface{}) []interface{} {
 	return reversed
 }
 
-// Chunk Returns a new slice(chunks) of slices. Every slice has fixed number of elements which was given as a limit in the 2nd parameter
+// Chunk Returns a new slice(chunks) of slices. Every slice has fixed number of elements which was given as a limit in the 2nd parameter.
+// It panics if the size isn't greater than 0
 func Chunk(items []interface{}, size int) []interface{} {
 	chunks := []interface{}{}
-	startAt := 0
-	lengthOfItems := len(items)
-	if lengthOfItems == 0 {
-		return chunks
-	}
-	if size >= lengthOfItems {
-		return append(chunks, items)
-	}
-	for startAt <= lengthOfItems {
-		upperLimit := startAt + size
-		if upperLimit < lengthOfItems {
-			chunks = append(chunks, items[startAt:upperLimit])
-		} else {
-			chunks = append(chunks, items[startAt:])
-		}
-		startAt = upperLimit
+	for _, chunk := range generic.Chunk(items, size) {
+		chunks = append(chunks, chunk)
 	}
 	return chunks
 }
@@ -188,55 +235,178 @@ func Range(args ...int) []int {
 	return items
 }
 
+// itemSet holds hashable items in a map and falls back to a linear scan for the others
+type itemSet struct {
+	hashed map[interface{}]struct{}
+	others []interface{}
+}
+
+// hashable reports whether item can be used as a map key. Unlike Type.Comparable it also checks
+// the values held by interface fields of structs and arrays
+func hashable(item interface{}) bool {
+	return item == nil || reflect.ValueOf(item).Comparable()
+}
+
+func newItemSet(items []interface{}) *itemSet {
+	set := &itemSet{hashed: make(map[interface{}]struct{}, len(items))}
+	for _, item := range items {
+		set.add(item)
+	}
+	return set
+}
+
+func (s *itemSet) add(item interface{}) {
+	if hashable(item) {
+		s.hashed[item] = struct{}{}
+		return
+	}
+	s.others = append(s.others, item)
+}
+
+func (s *itemSet) has(item interface{}) bool {
+	if hashable(item) {
+		_, exists := s.hashed[item]
+		return exists
+	}
+	return Contains(s.others, item)
+}
+
 // Uniq returns a new slice of unique items
 func Uniq(items []interface{}) []interface{} {
 	uniqueItems := []interface{}{}
+	seen := newItemSet(nil)
+	for _, item := range items {
+		if !seen.has(item) {
+			seen.add(item)
+			uniqueItems = append(uniqueItems, item)
+		}
+	}
+	return uniqueItems
+}
+
+// Union returns a new slice of the unique items of both slices in the order of first occurrence
+func Union(a []interface{}, b []interface{}) []interface{} {
+	return Uniq(append(append(make([]interface{}, 0, len(a)+len(b)), a...), b...))
+}
+
+// Intersection returns a new slice of the unique items of a which exist in b, in the order of a
+func Intersection(a []interface{}, b []interface{}) []interface{} {
+	other := newItemSet(b)
+	seen := newItemSet(nil)
+	intersection := []interface{}{}
+	for _, item := range a {
+		if other.has(item) && !seen.has(item) {
+			seen.add(item)
+			intersection = append(intersection, item)
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new slice of the unique items of a which don't exist in b, in the order of a
+func Difference(a []interface{}, b []interface{}) []interface{} {
+	excluded := newItemSet(b)
+	difference := []interface{}{}
+	for _, item := range a {
+		if !excluded.has(item) {
+			excluded.add(item)
+			difference = append(difference, item)
+		}
+	}
+	return difference
+}
+
+// UniqBy returns a new slice of items with unique keys returned from the function, keeping the first item of each key
+func UniqBy(items []interface{}, fn func(item interface{}) interface{}) []interface{} {
+	uniqueItems := []interface{}{}
+	seen := newItemSet(nil)
 	for _, item := range items {
-		if !Contains(uniqueItems, item) {
+		key := fn(item)
+		if !seen.has(key) {
+			seen.add(key)
 			uniqueItems = append(uniqueItems, item)
 		}
 	}
 	return uniqueItems
 }
 
+// UniqWith returns a new slice of unique items where equality is decided by the function
+func UniqWith(items []interface{}, equal func(a interface{}, b interface{}) bool) []interface{} {
+	uniqueItems := []interface{}{}
+	for _, item := range items {
+		if !ContainsFunc(uniqueItems, func(_ int, unique interface{}) bool { return equal(unique, item) }) {
+			uniqueItems = append(uniqueItems, item)
+		}
+	}
+	return uniqueItems
+}
+
+// UniqDeep returns a new slice of unique items compared with reflect.DeepEqual, it works with maps and slices too
+func UniqDeep(items []interface{}) []interface{} {
+	return UniqWith(items, reflect.DeepEqual)
+}
+
+// equal compares with == and reports false for uncomparable values such as maps and slices, which == would panic on
+func equal(a interface{}, b interface{}) bool {
+	return hashable(a) && hashable(b) && a == b
+}
+
 // IndexOf returns the poisition of the item in a slice, if item doesn't exist returns -1 otherwise
 func IndexOf(items []interface{}, item interface{}) int {
+	return IndexOfFunc(items, func(_ int, value interface{}) bool { return equal(value, item) })
+}
+
+// IndexOfFunc returns the position of the first item which satisfies the condition, -1 if there is no such item
+func IndexOfFunc(items []interface{}, fn func(index int, item interface{}) bool) int {
 	for index, value := range items {
-		if value == item {
+		if fn(index, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the position of the last occurrence of the item in a slice, -1 if item doesn't exist
+func LastIndexOf(items []interface{}, item interface{}) int {
+	return LastIndexOfFunc(items, func(_ int, value interface{}) bool { return equal(value, item) })
+}
+
+// LastIndexOfFunc returns the position of the last item which satisfies the condition, -1 if there is no such item
+func LastIndexOfFunc(items []interface{}, fn func(index int, item interface{}) bool) int {
+	for index := len(items) - 1; index >= 0; index-- {
+		if fn(index, items[index]) {
 			return index
 		}
 	}
 	return -1
 }
 
+// IndexOfDeep returns the position of the first item equal to item according to reflect.DeepEqual, -1 if item doesn't exist
+func IndexOfDeep(items []interface{}, item interface{}) int {
+	return IndexOfFunc(items, func(_ int, value interface{}) bool { return reflect.DeepEqual(value, item) })
+}
+
 // Contains returns true if item exists in the slice and false otherwise
 func Contains(items []interface{}, item interface{}) bool {
 	return IndexOf(items, item) > -1
 }
 
-//Shuffle returns a new slice with shuffled elements
-func Shuffle(items []interface{}) []interface{} {
-	copiedSlice := make([]interface{}, len(items))
-	for i := range items {
-		copiedSlice[i] = items[i]
-	}
-	for i := range items {
-		index := Randomer().Intn(len(items) - 1)
-		temp := copiedSlice[index]
-		copiedSlice[index] = copiedSlice[i]
-		copiedSlice[i] = temp
-	}
-	return copiedSlice
+// ContainsFunc returns true if any item satisfies the condition
+func ContainsFunc(items []interface{}, fn func(index int, item interface{}) bool) bool {
+	return IndexOfFunc(items, fn) > -1
 }
 
-var prevChosenItem interface{}
+// ContainsDeep returns true if an item equal to item according to reflect.DeepEqual exists in the slice
+func ContainsDeep(items []interface{}, item interface{}) bool {
+	return IndexOfDeep(items, item) > -1
+}
 
-//ChooseRandom returns a random element from the slice
-func ChooseRandom(items []interface{}) interface{} {
-	index := Randomer().Intn(len(items) - 1)
-	for items[index] == prevChosenItem {
-		index = Randomer().Intn(len(items) - 1)
-	}
-	prevChosenItem = items[index]
-	return items[index]
+// Shuffle returns a new slice with shuffled elements, every order is equally likely
+func Shuffle(items []interface{}, opts ...RandomOption) []interface{} {
+	return generic.Shuffle(items, opts...)
+}
+
+// ChooseRandom returns a random element from the slice, nil if the slice is empty
+func ChooseRandom(items []interface{}, opts ...RandomOption) interface{} {
+	return generic.ChooseRandom(items, opts...)
 }
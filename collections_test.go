@@ -2,6 +2,7 @@ package gofp
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 )
@@ -26,6 +27,27 @@ func Test_Fill(t *testing.T) {
 		t.Errorf("Fill() = %v, want %v", result, true)
 	}
 }
+func Test_FillWith(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5}
+	tests := []struct {
+		opts []FillOption
+		want []interface{}
+	}{
+		{nil, []interface{}{0, 0, 0, 0, 0}},
+		{[]FillOption{FillFrom(3)}, []interface{}{1, 2, 3, 0, 0}},
+		{[]FillOption{FillUntil(2)}, []interface{}{0, 0, 3, 4, 5}},
+		{[]FillOption{FillFrom(1), FillUntil(-1)}, []interface{}{1, 0, 0, 0, 5}},
+	}
+	for _, tt := range tests {
+		if got := FillWith(items, 0, tt.opts...); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FillWith() = %v, want %v", got, tt.want)
+		}
+	}
+	if !reflect.DeepEqual(items, []interface{}{1, 2, 3, 4, 5}) {
+		t.Errorf("FillWith() modified the source slice: %v", items)
+	}
+}
+
 func Test_Every(t *testing.T) {
 	isEveryOneIsAdult := Every([]interface{}{18, 20, 23, 40, 25}, func(i int, age interface{}) bool {
 		return age.(int) >= 18
@@ -122,6 +144,23 @@ func Test_Chunk(t *testing.T) {
 	}
 }
 
+func Test_ChunkExactSize(t *testing.T) {
+	// [[1,2],[3,4]]
+	chunkedItems := Chunk([]interface{}{1, 2, 3, 4}, 2)
+	if len(chunkedItems) != 2 {
+		t.Errorf("Chunk() = %v, want %v chunks", chunkedItems, 2)
+	}
+}
+
+func Test_ChunkInvalidSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with size 0 didn't panic")
+		}
+	}()
+	Chunk([]interface{}{1, 2}, 0)
+}
+
 func Test_Range(t *testing.T) {
 	// [5,6,7,8,9,10]
 	rangeItems := Range(5, 10)
@@ -138,6 +177,120 @@ func Test_Uniq(t *testing.T) {
 	}
 }
 
+func Test_UniqUnhashable(t *testing.T) {
+	type wrapper struct {
+		value interface{}
+	}
+	items := []interface{}{wrapper{[]int{1}}, wrapper{[]int{1}}, wrapper{1}, wrapper{1}}
+	want := []interface{}{wrapper{[]int{1}}, wrapper{[]int{1}}, wrapper{1}}
+	if uniqueItems := Uniq(items); !reflect.DeepEqual(uniqueItems, want) {
+		t.Errorf("Uniq() = %v, want %v", uniqueItems, want)
+	}
+	if keys := UniqBy(items, func(item interface{}) interface{} { return item }); !reflect.DeepEqual(keys, want) {
+		t.Errorf("UniqBy() = %v, want %v", keys, want)
+	}
+	if intersection := Intersection(items, items[2:]); !reflect.DeepEqual(intersection, want[2:]) {
+		t.Errorf("Intersection() = %v, want %v", intersection, want[2:])
+	}
+	if difference := Difference(items, items[2:]); !reflect.DeepEqual(difference, want[:2]) {
+		t.Errorf("Difference() = %v, want %v", difference, want[:2])
+	}
+}
+
+func Test_UniqBy(t *testing.T) {
+	records := []interface{}{
+		map[string]interface{}{"id": 1, "name": "Ron"},
+		map[string]interface{}{"id": 2, "name": "Sofia"},
+		map[string]interface{}{"id": 1, "name": "Roni"},
+	}
+	uniqueItems := UniqBy(records, func(item interface{}) interface{} {
+		return item.(map[string]interface{})["id"]
+	})
+	if want := records[:2]; !reflect.DeepEqual(uniqueItems, want) {
+		t.Errorf("UniqBy() = %v, want %v", uniqueItems, want)
+	}
+}
+
+func Test_UniqDeep(t *testing.T) {
+	items := []interface{}{[]int{1}, map[string]interface{}{"a": 1}, []int{1}, map[string]interface{}{"a": 1}}
+	uniqueItems := UniqDeep(items)
+	if want := items[:2]; !reflect.DeepEqual(uniqueItems, want) {
+		t.Errorf("UniqDeep() = %v, want %v", uniqueItems, want)
+	}
+}
+
+func Test_IndexOfUncomparable(t *testing.T) {
+	items := []interface{}{map[string]interface{}{"a": 1}, []int{1}, 10}
+	if index := IndexOf(items, []int{1}); index != -1 {
+		t.Errorf("IndexOf() = %v, want %v", index, -1)
+	}
+	if index := IndexOf(items, 10); index != 2 {
+		t.Errorf("IndexOf() = %v, want %v", index, 2)
+	}
+	if index := IndexOfDeep(items, []int{1}); index != 1 {
+		t.Errorf("IndexOfDeep() = %v, want %v", index, 1)
+	}
+	if !ContainsDeep(items, map[string]interface{}{"a": 1}) {
+		t.Errorf("ContainsDeep() = %v, want %v", false, true)
+	}
+	type holder struct {
+		Value interface{}
+	}
+	holders := []interface{}{holder{Value: []int{1}}, holder{Value: 1}}
+	if index := IndexOf(holders, holder{Value: []int{1}}); index != -1 {
+		t.Errorf("IndexOf() = %v, want %v", index, -1)
+	}
+	if index := IndexOf(holders, holder{Value: 1}); index != 1 {
+		t.Errorf("IndexOf() = %v, want %v", index, 1)
+	}
+}
+
+func Test_IndexOfFunc(t *testing.T) {
+	index := IndexOfFunc([]interface{}{1, 2, 12, 30}, func(i int, item interface{}) bool {
+		return item.(int) > 10
+	})
+	if index != 2 {
+		t.Errorf("IndexOfFunc() = %v, want %v", index, 2)
+	}
+	if ContainsFunc([]interface{}{1, 2}, func(i int, item interface{}) bool { return item.(int) > 10 }) {
+		t.Errorf("ContainsFunc() = %v, want %v", true, false)
+	}
+}
+
+func Test_LastIndexOf(t *testing.T) {
+	index := LastIndexOf([]interface{}{1, 2, 2, 3, 10, 4, 5, 10, 100}, 10)
+	if index != 7 {
+		t.Errorf("LastIndexOf() = %v, want %v", index, 7)
+	}
+	index = LastIndexOfFunc([]interface{}{1, 12, 30, 2}, func(i int, item interface{}) bool {
+		return item.(int) > 10
+	})
+	if index != 2 {
+		t.Errorf("LastIndexOfFunc() = %v, want %v", index, 2)
+	}
+}
+
+func Test_Union(t *testing.T) {
+	union := Union([]interface{}{3, 1, 3}, []interface{}{2, 1, "4"})
+	if want := []interface{}{3, 1, 2, "4"}; !reflect.DeepEqual(union, want) {
+		t.Errorf("Union() = %v, want %v", union, want)
+	}
+}
+
+func Test_Intersection(t *testing.T) {
+	intersection := Intersection([]interface{}{"c", "a", "b", "a"}, []interface{}{"a", "c"})
+	if want := []interface{}{"c", "a"}; !reflect.DeepEqual(intersection, want) {
+		t.Errorf("Intersection() = %v, want %v", intersection, want)
+	}
+}
+
+func Test_Difference(t *testing.T) {
+	difference := Difference([]interface{}{5, 1, 2, 5, nil, []int{3}}, []interface{}{2})
+	if want := []interface{}{5, 1, nil, []int{3}}; !reflect.DeepEqual(difference, want) {
+		t.Errorf("Difference() = %v, want %v", difference, want)
+	}
+}
+
 func Test_IndexOf(t *testing.T) {
 	// 4
 	index := IndexOf([]interface{}{1, 2, 2, 3, 10, 4, 5, 10, 100}, 10)
@@ -155,7 +308,7 @@ func Test_Contains(t *testing.T) {
 }
 
 func Test_Shuffle(t *testing.T) {
-	shuffledItems := Shuffle([]interface{}{1, 2, 3, 4, 5})
+	shuffledItems := Shuffle([]interface{}{1, 2, 3, 4, 5}, WithSeed(1))
 	hasSameItemsInSamePosition := shuffledItems[0].(int) == 1 && shuffledItems[1].(int) == 2 && shuffledItems[2].(int) == 3 && shuffledItems[3].(int) == 4 && shuffledItems[4].(int) == 5
 	if hasSameItemsInSamePosition {
 		t.Errorf("Shuffle() = %v, want %v", hasSameItemsInSamePosition, false)
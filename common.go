@@ -2,15 +2,26 @@ package gofp
 
 import (
 	"math/rand"
-	"time"
+	randv2 "math/rand/v2"
 )
 
-//Randomer returns a Rand with seed which helps to generate unique randome numbers each time
+//Randomer returns a Rand drawing from the shared generator which RandomSourceOf uses without options.
+//Seeding it has no effect.
+//
+//Deprecated: use RandomSourceOf, or WithSeed for reproducible numbers.
 func Randomer() *rand.Rand {
-	seed := rand.NewSource(time.Now().UnixNano())
-	return rand.New(seed)
+	return rand.New(sharedSource{})
 }
 
+// sharedSource is a math/rand Source drawing from the goroutine-safe top level functions of math/rand/v2
+type sharedSource struct{}
+
+func (sharedSource) Int63() int64 {
+	return randv2.Int64()
+}
+
+func (sharedSource) Seed(int64) {}
+
 //StringToInterfaceSlice converts a slice of string to slice of interface
 func StringToInterfaceSlice(stringSlice []string) []interface{} {
 	interfaceSlice := make([]interface{}, len(stringSlice), len(stringSlice))
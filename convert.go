@@ -0,0 +1,173 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ErrOutOfRange is wrapped by a ConversionError when a number doesn't fit into the target type
+var ErrOutOfRange = errors.New("value out of range")
+
+// ConversionError reports a value which can't be converted to the target type
+type ConversionError struct {
+	Value  interface{}
+	Target string
+	Err    error
+}
+
+func (e *ConversionError) Error() string {
+	message := fmt.Sprintf("cannot convert %T %v to %s", e.Value, e.Value, e.Target)
+	if e.Value == nil {
+		message = "cannot convert nil to " + e.Target
+	}
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+	return message
+}
+
+func (e *ConversionError) Unwrap() error {
+	return e.Err
+}
+
+// numberValue returns the value to convert to a number, strings are read as json.Number
+func numberValue(value interface{}) reflect.Value {
+	number := reflect.ValueOf(value)
+	if number.Kind() == reflect.String {
+		return reflect.ValueOf(json.Number(number.String()))
+	}
+	return number
+}
+
+// CoerceInt converts any integer, a float64 or json.Number holding an integral number or a numeric string to int
+func CoerceInt(value interface{}) (int, error) {
+	if value == nil {
+		return 0, &ConversionError{Value: value, Target: "int"}
+	}
+	number, ok := integerValue(numberValue(value))
+	if !ok {
+		return 0, &ConversionError{Value: value, Target: "int"}
+	}
+	if !number.IsInt64() || number.Int64() < math.MinInt || number.Int64() > math.MaxInt {
+		return 0, &ConversionError{Value: value, Target: "int", Err: ErrOutOfRange}
+	}
+	return int(number.Int64()), nil
+}
+
+// CoerceFloat64 converts any number, json.Number or numeric string to float64. Strings such as "NaN"
+// or "Infinity" aren't numeric, a float64 holding NaN or an infinity is returned as it is
+func CoerceFloat64(value interface{}) (float64, error) {
+	if value == nil {
+		return 0, &ConversionError{Value: value, Target: "float64"}
+	}
+	number, ok := floatValue(numberValue(value))
+	if reflect.ValueOf(value).Kind() == reflect.String && (math.IsNaN(number) || math.IsInf(number, 0)) {
+		ok = false
+	}
+	if !ok {
+		return 0, &ConversionError{Value: value, Target: "float64"}
+	}
+	return number, nil
+}
+
+// CoerceString converts a string, json.Number, number or bool to string
+func CoerceString(value interface{}) (string, error) {
+	converted := reflect.ValueOf(value)
+	switch {
+	case converted.Kind() == reflect.String:
+		return converted.String(), nil
+	case converted.CanInt():
+		return strconv.FormatInt(converted.Int(), 10), nil
+	case converted.CanUint():
+		return strconv.FormatUint(converted.Uint(), 10), nil
+	case converted.CanFloat():
+		return strconv.FormatFloat(converted.Float(), 'g', -1, converted.Type().Bits()), nil
+	case converted.Kind() == reflect.Bool:
+		return strconv.FormatBool(converted.Bool()), nil
+	}
+	return "", &ConversionError{Value: value, Target: "string"}
+}
+
+// CoerceBool converts a bool or a string understood by strconv.ParseBool to bool
+func CoerceBool(value interface{}) (bool, error) {
+	converted := reflect.ValueOf(value)
+	switch converted.Kind() {
+	case reflect.Bool:
+		return converted.Bool(), nil
+	case reflect.String:
+		if parsed, err := strconv.ParseBool(converted.String()); err == nil {
+			return parsed, nil
+		}
+	}
+	return false, &ConversionError{Value: value, Target: "bool"}
+}
+
+// convertSlice converts every item with fn, the error names the index of the first failing item
+func convertSlice[T any](items []interface{}, fn func(item interface{}) (T, error)) ([]T, error) {
+	converted := make([]T, len(items))
+	for i, item := range items {
+		value, err := fn(item)
+		if err != nil {
+			return nil, &ElementError{Index: i, Err: err}
+		}
+		converted[i] = value
+	}
+	return converted, nil
+}
+
+// assertItem returns the item if it has the type T
+func assertItem[T any](item interface{}) (T, error) {
+	value, ok := item.(T)
+	if !ok {
+		return value, &ConversionError{Value: item, Target: reflect.TypeFor[T]().String()}
+	}
+	return value, nil
+}
+
+// TryStringSlice works like StringSlice but returns an error naming the first item which isn't a string instead of panicking
+func TryStringSlice(items []interface{}) ([]string, error) {
+	return convertSlice(items, assertItem[string])
+}
+
+// TryIntSlice works like IntSlice but returns an error naming the first item which isn't an int instead of panicking
+func TryIntSlice(items []interface{}) ([]int, error) {
+	return convertSlice(items, assertItem[int])
+}
+
+// TryFloat64Slice works like Float64Slice but returns an error naming the first item which isn't a float64 instead of panicking
+func TryFloat64Slice(items []interface{}) ([]float64, error) {
+	return convertSlice(items, assertItem[float64])
+}
+
+// CoerceStringSlice converts every item with CoerceString, the error names the first item which can't be converted
+func CoerceStringSlice(items []interface{}) ([]string, error) {
+	return convertSlice(items, CoerceString)
+}
+
+// CoerceIntSlice converts every item with CoerceInt, the error names the first item which can't be converted
+func CoerceIntSlice(items []interface{}) ([]int, error) {
+	return convertSlice(items, CoerceInt)
+}
+
+// CoerceFloat64Slice converts every item with CoerceFloat64, the error names the first item which can't be converted
+func CoerceFloat64Slice(items []interface{}) ([]float64, error) {
+	return convertSlice(items, CoerceFloat64)
+}
+
+// ToSlice converts a slice of interface to a slice of T, the error names the first item which isn't a T
+func ToSlice[T any](items []interface{}) ([]T, error) {
+	return convertSlice(items, assertItem[T])
+}
+
+// FromSlice converts a slice of any type to a slice of interface
+func FromSlice[T any](items []T) []interface{} {
+	converted := make([]interface{}, len(items))
+	for i, item := range items {
+		converted[i] = item
+	}
+	return converted
+}
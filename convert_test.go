@@ -0,0 +1,137 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func Test_TryIntSlice(t *testing.T) {
+	ints, err := TryIntSlice([]interface{}{1, 2, 3})
+	if err != nil || !reflect.DeepEqual(ints, []int{1, 2, 3}) {
+		t.Errorf("TryIntSlice() = %v, %v, want %v, %v", ints, err, []int{1, 2, 3}, nil)
+	}
+	_, err = TryIntSlice([]interface{}{1, 2.0, 3})
+	var elementErr *ElementError
+	if !errors.As(err, &elementErr) || elementErr.Index != 1 {
+		t.Errorf("TryIntSlice() error = %v, want an error at index %v", err, 1)
+	}
+	if want := "item 1 failed: cannot convert float64 2 to int"; err.Error() != want {
+		t.Errorf("TryIntSlice() error = %q, want %q", err.Error(), want)
+	}
+}
+
+func Test_TryStringSlice(t *testing.T) {
+	if strings, err := TryStringSlice([]interface{}{"a", "b"}); err != nil || !reflect.DeepEqual(strings, []string{"a", "b"}) {
+		t.Errorf("TryStringSlice() = %v, %v, want %v, %v", strings, err, []string{"a", "b"}, nil)
+	}
+	var conversionErr *ConversionError
+	if _, err := TryStringSlice([]interface{}{"a", nil}); !errors.As(err, &conversionErr) || conversionErr.Target != "string" {
+		t.Errorf("TryStringSlice() error = %v, want a ConversionError to string", err)
+	}
+}
+
+func Test_TryFloat64Slice(t *testing.T) {
+	if _, err := TryFloat64Slice([]interface{}{1.5, "2"}); err == nil {
+		t.Errorf("TryFloat64Slice() error = %v, want an error", err)
+	}
+}
+
+func Test_CoerceInt(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		want  int
+		ok    bool
+	}{
+		{42, 42, true},
+		{uint8(7), 7, true},
+		{3.0, 3, true},
+		{3.5, 0, false},
+		{json.Number("12"), 12, true},
+		{json.Number("1e3"), 1000, true},
+		{"-8", -8, true},
+		{"eight", 0, false},
+		{true, 0, false},
+		{nil, 0, false},
+		{uint64(math.MaxUint64), 0, false},
+	}
+	for _, tt := range tests {
+		got, err := CoerceInt(tt.value)
+		if got != tt.want || (err == nil) != tt.ok {
+			t.Errorf("CoerceInt(%#v) = %v, %v, want %v, ok %v", tt.value, got, err, tt.want, tt.ok)
+		}
+	}
+	if _, err := CoerceInt(1e300); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("CoerceInt() error = %v, want %v", err, ErrOutOfRange)
+	}
+}
+
+func Test_CoerceFloat64(t *testing.T) {
+	for value, want := range map[interface{}]float64{7: 7, float32(0.5): 0.5, json.Number("2.25"): 2.25, "1e2": 100} {
+		if got, err := CoerceFloat64(value); err != nil || got != want {
+			t.Errorf("CoerceFloat64(%#v) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if _, err := CoerceFloat64([]int{1}); err == nil {
+		t.Errorf("CoerceFloat64() error = %v, want an error", err)
+	}
+	for _, value := range []interface{}{"Infinity", "-Inf", "NaN", json.Number("Inf")} {
+		var conversionErr *ConversionError
+		if got, err := CoerceFloat64(value); !errors.As(err, &conversionErr) {
+			t.Errorf("CoerceFloat64(%#v) = %v, %v, want a *ConversionError", value, got, err)
+		}
+		if got, err := CoerceInt(value); err == nil {
+			t.Errorf("CoerceInt(%#v) = %v, %v, want an error", value, got, err)
+		}
+	}
+	if got, err := CoerceFloat64(math.Inf(1)); err != nil || !math.IsInf(got, 1) {
+		t.Errorf("CoerceFloat64() = %v, %v, want %v", got, err, math.Inf(1))
+	}
+}
+
+func Test_CoerceStringAndBool(t *testing.T) {
+	for value, want := range map[interface{}]string{"a": "a", 12: "12", 1.5: "1.5", json.Number("3"): "3", false: "false"} {
+		if got, err := CoerceString(value); err != nil || got != want {
+			t.Errorf("CoerceString(%#v) = %v, %v, want %v", value, got, err, want)
+		}
+	}
+	if got, err := CoerceBool("true"); err != nil || !got {
+		t.Errorf("CoerceBool() = %v, %v, want %v", got, err, true)
+	}
+	if _, err := CoerceBool(1); err == nil {
+		t.Errorf("CoerceBool() error = %v, want an error", err)
+	}
+}
+
+func Test_CoerceSlices(t *testing.T) {
+	decoded := []interface{}{1.0, json.Number("2"), "3"}
+	if ints, err := CoerceIntSlice(decoded); err != nil || !reflect.DeepEqual(ints, []int{1, 2, 3}) {
+		t.Errorf("CoerceIntSlice() = %v, %v, want %v", ints, err, []int{1, 2, 3})
+	}
+	if floats, err := CoerceFloat64Slice(decoded); err != nil || !reflect.DeepEqual(floats, []float64{1, 2, 3}) {
+		t.Errorf("CoerceFloat64Slice() = %v, %v, want %v", floats, err, []float64{1, 2, 3})
+	}
+	if strings, err := CoerceStringSlice(decoded); err != nil || !reflect.DeepEqual(strings, []string{"1", "2", "3"}) {
+		t.Errorf("CoerceStringSlice() = %v, %v, want %v", strings, err, []string{"1", "2", "3"})
+	}
+	var elementErr *ElementError
+	if _, err := CoerceIntSlice([]interface{}{1, 2.5}); !errors.As(err, &elementErr) || elementErr.Index != 1 {
+		t.Errorf("CoerceIntSlice() error = %v, want an error at index %v", err, 1)
+	}
+}
+
+func Test_ToSliceFromSlice(t *testing.T) {
+	items := FromSlice([]string{"name", "age"})
+	if !reflect.DeepEqual(items, []interface{}{"name", "age"}) {
+		t.Errorf("FromSlice() = %v, want %v", items, []interface{}{"name", "age"})
+	}
+	strings, err := ToSlice[string](items)
+	if err != nil || !reflect.DeepEqual(strings, []string{"name", "age"}) {
+		t.Errorf("ToSlice() = %v, %v, want %v", strings, err, []string{"name", "age"})
+	}
+	if _, err := ToSlice[int](items); err == nil {
+		t.Errorf("ToSlice() error = %v, want an error", err)
+	}
+}
@@ -0,0 +1,144 @@
+package gofp
+
+import (
+	"sort"
+	"strconv"
+)
+
+type flattenConfig struct {
+	separator      string
+	bracketIndexes bool
+	maxDepth       int
+	// maxIndex is the largest index Unflatten turns into a slice element, larger ones stay map keys
+	maxIndex int
+}
+
+// FlattenOption configures Flatten and Unflatten
+type FlattenOption func(*flattenConfig)
+
+// WithSeparator sets the string separating the keys, it defaults to a dot
+func WithSeparator(separator string) FlattenOption {
+	return func(config *flattenConfig) {
+		if separator != "" {
+			config.separator = separator
+		}
+	}
+}
+
+// WithBracketIndexes writes slice indexes as a[0] instead of a.0
+func WithBracketIndexes() FlattenOption {
+	return func(config *flattenConfig) {
+		config.bracketIndexes = true
+	}
+}
+
+// WithMaxDepth limits the number of keys joined in a flattened key, deeper values are kept as they are
+func WithMaxDepth(depth int) FlattenOption {
+	return func(config *flattenConfig) {
+		config.maxDepth = depth
+	}
+}
+
+func newFlattenConfig(opts []FlattenOption) flattenConfig {
+	config := flattenConfig{separator: "."}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// Flatten returns a map of the values of nested maps and slices under keys such as "a.b.0.c".
+// With the default options the keys use the path syntax of Get, so Get(mapData, key) reads the
+// value back. Empty maps and slices are kept as values. Map keys which look like slice indexes
+// are written as ["0"] unless WithBracketIndexes is used, so Unflatten can tell them apart
+func Flatten(mapData map[string]interface{}, opts ...FlattenOption) map[string]interface{} {
+	config := newFlattenConfig(opts)
+	flat := map[string]interface{}{}
+	for key, value := range mapData {
+		config.flattenInto(flat, config.keyOf(key), value, 1)
+	}
+	return flat
+}
+
+func (config flattenConfig) flattenInto(flat map[string]interface{}, prefix string, data interface{}, depth int) {
+	if config.maxDepth <= 0 || depth < config.maxDepth {
+		if mapData, ok := asStringMap(data); ok && len(mapData) > 0 {
+			for key, value := range mapData {
+				config.flattenInto(flat, prefix+config.separator+config.keyOf(key), value, depth+1)
+			}
+			return
+		}
+		if slice, ok := sliceValue(data); ok && slice.Len() > 0 {
+			for i := 0; i < slice.Len(); i++ {
+				config.flattenInto(flat, prefix+config.indexOf(i), slice.Index(i).Interface(), depth+1)
+			}
+			return
+		}
+	}
+	flat[prefix] = data
+}
+
+func (config flattenConfig) keyOf(key string) string {
+	if _, err := strconv.Atoi(key); err == nil && !config.bracketIndexes {
+		return `["` + key + `"]`
+	}
+	return escapeKey(key, config.separator)
+}
+
+func (config flattenConfig) indexOf(index int) string {
+	if config.bracketIndexes {
+		return "[" + strconv.Itoa(index) + "]"
+	}
+	return config.separator + strconv.Itoa(index)
+}
+
+// Unflatten rebuilds nested maps and slices from a map produced by Flatten with the same options.
+// Keys which can't be parsed are kept as they are. Indexes greater than the number of keys would
+// only pad a slice with nils, so they are treated as map keys instead
+func Unflatten(flat map[string]interface{}, opts ...FlattenOption) map[string]interface{} {
+	config := newFlattenConfig(opts)
+	config.maxIndex = len(flat)
+	keys := Keys(flat)
+	sort.Strings(keys)
+	var tree interface{} = map[string]interface{}{}
+	for _, key := range keys {
+		segments, err := splitPath(key, config.separator)
+		if err != nil || len(segments) == 0 || config.isIndex(segments[0]) {
+			tree.(map[string]interface{})[key] = flat[key]
+			continue
+		}
+		tree = config.insert(tree, segments, flat[key])
+	}
+	return tree.(map[string]interface{})
+}
+
+// insert stores value at segments inside data, creating slices for index segments and maps otherwise
+func (config flattenConfig) insert(data interface{}, segments []pathSegment, value interface{}) interface{} {
+	if len(segments) == 0 {
+		return value
+	}
+	segment := segments[0]
+	if config.isIndex(segment) {
+		index, _ := strconv.Atoi(segment.key)
+		items, _ := data.([]interface{})
+		if index >= len(items) {
+			items = append(items, make([]interface{}, index+1-len(items))...)
+		}
+		items[index] = config.insert(items[index], segments[1:], value)
+		return items
+	}
+	mapData, ok := data.(map[string]interface{})
+	if !ok {
+		mapData = map[string]interface{}{}
+	}
+	mapData[segment.key] = config.insert(mapData[segment.key], segments[1:], value)
+	return mapData
+}
+
+func (config flattenConfig) isIndex(segment pathSegment) bool {
+	if segment.quoted || segment.kind != segmentKey || (config.bracketIndexes && !segment.indexed) {
+		return false
+	}
+	index, err := strconv.Atoi(segment.key)
+	return err == nil && index >= 0 && index <= config.maxIndex && segment.key == strconv.Itoa(index)
+}
@@ -0,0 +1,106 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+var nestedConfig = map[string]interface{}{
+	"name": "api",
+	"server": map[string]interface{}{
+		"hosts": []interface{}{
+			map[string]interface{}{"name": "a", "port": 80},
+			map[string]interface{}{"name": "b", "port": 443},
+		},
+		"example.com": true,
+	},
+	"codes":  map[string]interface{}{"404": "not found"},
+	"empty":  map[string]interface{}{},
+	"labels": []string{"x", "y"},
+}
+
+func Test_Flatten(t *testing.T) {
+	got := Flatten(nestedConfig)
+	want := map[string]interface{}{
+		"name":                "api",
+		"server.hosts.0.name": "a",
+		"server.hosts.0.port": 80,
+		"server.hosts.1.name": "b",
+		"server.hosts.1.port": 443,
+		`server.example\.com`: true,
+		`codes.["404"]`:       "not found",
+		"empty":               map[string]interface{}{},
+		"labels.0":            "x",
+		"labels.1":            "y",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+	for key, value := range got {
+		if !reflect.DeepEqual(Get(nestedConfig, key), value) {
+			t.Errorf("Get(%q) = %v, want %v", key, Get(nestedConfig, key), value)
+		}
+	}
+}
+
+func Test_FlattenOptions(t *testing.T) {
+	got := Flatten(nestedConfig, WithSeparator("__"), WithBracketIndexes(), WithMaxDepth(4))
+	want := map[string]interface{}{
+		"name":                   "api",
+		"server__hosts[0]__name": "a",
+		"server__hosts[0]__port": 80,
+		"server__hosts[1]__name": "b",
+		"server__hosts[1]__port": 443,
+		"server__example.com":    true,
+		"codes__404":             "not found",
+		"empty":                  map[string]interface{}{},
+		"labels[0]":              "x",
+		"labels[1]":              "y",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Flatten() = %v, want %v", got, want)
+	}
+	shallow := Flatten(nestedConfig, WithMaxDepth(2))
+	if _, ok := shallow["server.hosts"].([]interface{}); !ok {
+		t.Errorf("Flatten() = %v, want server.hosts to stay a slice", shallow)
+	}
+}
+
+func Test_Unflatten(t *testing.T) {
+	want := map[string]interface{}{
+		"name": "api",
+		"server": map[string]interface{}{
+			"hosts": []interface{}{
+				map[string]interface{}{"name": "a", "port": 80},
+				map[string]interface{}{"name": "b", "port": 443},
+			},
+			"example.com": true,
+		},
+		"codes":  map[string]interface{}{"404": "not found"},
+		"empty":  map[string]interface{}{},
+		"labels": []interface{}{"x", "y"},
+	}
+	for _, opts := range [][]FlattenOption{
+		{},
+		{WithBracketIndexes()},
+		{WithSeparator("__"), WithBracketIndexes()},
+		{WithSeparator("/")},
+	} {
+		got := Unflatten(Flatten(nestedConfig, opts...), opts...)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Unflatten(Flatten()) = %v, want %v", got, want)
+		}
+	}
+	sparse := Unflatten(map[string]interface{}{"items.2": "c", "bad..key": 1})
+	if !reflect.DeepEqual(sparse, map[string]interface{}{"items": []interface{}{nil, nil, "c"}, "bad..key": 1}) {
+		t.Errorf("Unflatten() = %v", sparse)
+	}
+	huge := Unflatten(map[string]interface{}{"a.5000000000": 1})
+	if !reflect.DeepEqual(huge, map[string]interface{}{"a": map[string]interface{}{"5000000000": 1}}) {
+		t.Errorf("Unflatten() = %v, want the huge index kept as a map key", huge)
+	}
+	huge = Unflatten(map[string]interface{}{"b[9]": 2}, WithBracketIndexes())
+	if !reflect.DeepEqual(huge, map[string]interface{}{"b": map[string]interface{}{"9": 2}}) {
+		t.Errorf("Unflatten() = %v, want the huge index kept as a map key", huge)
+	}
+}
@@ -1,8 +1,9 @@
 package gofp
 
-/* TODO: FP Utils
-- Curry()
-*/
+import (
+	"context"
+	"fmt"
+)
 
 // Pipe allows to process the chainable functional operations
 func Pipe(fns ...(func(arg interface{}) interface{})) func(interface{}) interface{} {
@@ -26,3 +27,162 @@ func Compose(fns ...(func(arg interface{}) interface{})) func(interface{}) inter
 		return result
 	}
 }
+
+// StepError is returned by PipeE, ComposeE and their context aware variants when a step fails
+type StepError struct {
+	// Index is the position of the failed step in the list of functions passed to the pipeline
+	Index int
+	Err   error
+}
+
+func (e *StepError) Error() string {
+	return fmt.Sprintf("step %d failed: %v", e.Index, e.Err)
+}
+
+func (e *StepError) Unwrap() error {
+	return e.Err
+}
+
+// PipeE allows to process the chainable functional operations which can fail, it stops at the first error
+func PipeE(fns ...(func(arg interface{}) (interface{}, error))) func(interface{}) (interface{}, error) {
+	return func(data interface{}) (interface{}, error) {
+		var result interface{} = data
+		for i, fn := range fns {
+			value, err := fn(result)
+			if err != nil {
+				return nil, &StepError{Index: i, Err: err}
+			}
+			result = value
+		}
+		return result, nil
+	}
+}
+
+// ComposeE allows to process the chainable functional operations which can fail in reverse order, it stops at the first error
+func ComposeE(fns ...(func(arg interface{}) (interface{}, error))) func(interface{}) (interface{}, error) {
+	return func(data interface{}) (interface{}, error) {
+		var result interface{} = data
+		for i := len(fns) - 1; i >= 0; i-- {
+			value, err := fns[i](result)
+			if err != nil {
+				return nil, &StepError{Index: i, Err: err}
+			}
+			result = value
+		}
+		return result, nil
+	}
+}
+
+// PipeContext works like PipeE but passes the context to every step and stops when the context is done
+func PipeContext(fns ...(func(ctx context.Context, arg interface{}) (interface{}, error))) func(context.Context, interface{}) (interface{}, error) {
+	return func(ctx context.Context, data interface{}) (interface{}, error) {
+		var result interface{} = data
+		for i, fn := range fns {
+			value, err := runStep(ctx, fn, result)
+			if err != nil {
+				return nil, &StepError{Index: i, Err: err}
+			}
+			result = value
+		}
+		return result, nil
+	}
+}
+
+// ComposeContext works like ComposeE but passes the context to every step and stops when the context is done
+func ComposeContext(fns ...(func(ctx context.Context, arg interface{}) (interface{}, error))) func(context.Context, interface{}) (interface{}, error) {
+	return func(ctx context.Context, data interface{}) (interface{}, error) {
+		var result interface{} = data
+		for i := len(fns) - 1; i >= 0; i-- {
+			value, err := runStep(ctx, fns[i], result)
+			if err != nil {
+				return nil, &StepError{Index: i, Err: err}
+			}
+			result = value
+		}
+		return result, nil
+	}
+}
+
+func runStep(ctx context.Context, fn func(ctx context.Context, arg interface{}) (interface{}, error), data interface{}) (interface{}, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+	}
+	return fn(ctx, data)
+}
+
+// Curry2 converts a function of two arguments into a chain of functions taking one argument each
+func Curry2[A, B, R any](fn func(A, B) R) func(A) func(B) R {
+	return func(a A) func(B) R {
+		return func(b B) R {
+			return fn(a, b)
+		}
+	}
+}
+
+// Curry3 converts a function of three arguments into a chain of functions taking one argument each
+func Curry3[A, B, C, R any](fn func(A, B, C) R) func(A) func(B) func(C) R {
+	return func(a A) func(B) func(C) R {
+		return func(b B) func(C) R {
+			return func(c C) R {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
+
+// Curry4 converts a function of four arguments into a chain of functions taking one argument each
+func Curry4[A, B, C, D, R any](fn func(A, B, C, D) R) func(A) func(B) func(C) func(D) R {
+	return func(a A) func(B) func(C) func(D) R {
+		return func(b B) func(C) func(D) R {
+			return func(c C) func(D) R {
+				return func(d D) R {
+					return fn(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry2 converts a curried function back into a function of two arguments
+func Uncurry2[A, B, R any](fn func(A) func(B) R) func(A, B) R {
+	return func(a A, b B) R {
+		return fn(a)(b)
+	}
+}
+
+// Uncurry3 converts a curried function back into a function of three arguments
+func Uncurry3[A, B, C, R any](fn func(A) func(B) func(C) R) func(A, B, C) R {
+	return func(a A, b B, c C) R {
+		return fn(a)(b)(c)
+	}
+}
+
+// Uncurry4 converts a curried function back into a function of four arguments
+func Uncurry4[A, B, C, D, R any](fn func(A) func(B) func(C) func(D) R) func(A, B, C, D) R {
+	return func(a A, b B, c C, d D) R {
+		return fn(a)(b)(c)(d)
+	}
+}
+
+// Partial returns a function with the first argument of fn fixed to the given value
+func Partial[A, B, R any](fn func(A, B) R, a A) func(B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// PartialRight returns a function with the last argument of fn fixed to the given value
+func PartialRight[A, B, R any](fn func(A, B) R, b B) func(A) R {
+	return func(a A) R {
+		return fn(a, b)
+	}
+}
+
+// Flip returns a function which calls fn with its two arguments swapped
+func Flip[A, B, R any](fn func(A, B) R) func(B, A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
@@ -1,6 +1,8 @@
 package gofp
 
 import (
+	"context"
+	"errors"
 	"strings"
 	"testing"
 )
@@ -71,3 +73,178 @@ func Test_Compose(t *testing.T) {
 		t.Errorf("Pipe() = %v, want %v", email, "JOHNDOE@GMAIL.COM")
 	}
 }
+
+var errNoPhone = errors.New("no phone")
+
+func getContactsE(data interface{}) (interface{}, error) {
+	return data.(map[string]interface{})["contacts"], nil
+}
+
+func getPhoneE(data interface{}) (interface{}, error) {
+	phone, ok := data.(map[string]interface{})["phone"]
+	if !ok {
+		return nil, errNoPhone
+	}
+	return phone, nil
+}
+
+func Test_PipeE(t *testing.T) {
+	email, err := PipeE(
+		getContactsE,
+		func(data interface{}) (interface{}, error) {
+			return data.(map[string]interface{})["email"], nil
+		},
+	)(mockedUser)
+	if err != nil || email != "johndoe@gmail.com" {
+		t.Errorf("PipeE() = %v, %v, want %v, %v", email, err, "johndoe@gmail.com", nil)
+	}
+
+	called := false
+	_, err = PipeE(
+		getContactsE,
+		getPhoneE,
+		func(data interface{}) (interface{}, error) {
+			called = true
+			return data, nil
+		},
+	)(mockedUser)
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Index != 1 || !errors.Is(err, errNoPhone) || called {
+		t.Errorf("PipeE() error = %v, want failure at step %v", err, 1)
+	}
+}
+
+func Test_ComposeE(t *testing.T) {
+	_, err := ComposeE(getPhoneE, getContactsE)(mockedUser)
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Index != 0 {
+		t.Errorf("ComposeE() error = %v, want failure at step %v", err, 0)
+	}
+}
+
+func Test_PipeContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	steps := 0
+	step := func(ctx context.Context, data interface{}) (interface{}, error) {
+		steps++
+		if steps == 2 {
+			cancel()
+		}
+		return data, nil
+	}
+	_, err := PipeContext(step, step, step)(ctx, mockedUser)
+	var stepErr *StepError
+	if !errors.As(err, &stepErr) || stepErr.Index != 2 || !errors.Is(err, context.Canceled) || steps != 2 {
+		t.Errorf("PipeContext() error = %v after %v steps, want cancellation at step %v", err, steps, 2)
+	}
+}
+
+func Test_ComposeContext(t *testing.T) {
+	result, err := ComposeContext(
+		func(ctx context.Context, data interface{}) (interface{}, error) {
+			return strings.ToUpper(data.(string)), nil
+		},
+		func(ctx context.Context, data interface{}) (interface{}, error) {
+			return data.(map[string]interface{})["name"], nil
+		},
+	)(context.Background(), mockedUser)
+	if err != nil || result != "JOHN DOE" {
+		t.Errorf("ComposeContext() = %v, %v, want %v, %v", result, err, "JOHN DOE", nil)
+	}
+}
+
+func Test_Curry2(t *testing.T) {
+	add := Curry2(func(a int, b int) int {
+		return a + b
+	})
+	if add(2)(3) != 5 {
+		t.Errorf("Curry2() = %v, want %v", add(2)(3), 5)
+	}
+}
+
+func Test_Curry2WithPipe(t *testing.T) {
+	prop := Curry2(func(key interface{}, data interface{}) interface{} {
+		return data.(map[string]interface{})[key.(string)]
+	})
+	email := Pipe(prop("contacts"), prop("email"))(mockedUser)
+	if email != "johndoe@gmail.com" {
+		t.Errorf("Curry2() with Pipe() = %v, want %v", email, "johndoe@gmail.com")
+	}
+}
+
+func Test_Curry3(t *testing.T) {
+	between := Curry3(func(min int, max int, value interface{}) bool {
+		return value.(int) >= min && value.(int) <= max
+	})
+	isTeen := between(13)(19)
+	teens := Filter([]interface{}{12, 13, 17, 19, 20}, func(i int, age interface{}) bool {
+		return isTeen(age)
+	})
+	if len(teens) != 3 {
+		t.Errorf("Curry3() = %v, want %v", len(teens), 3)
+	}
+}
+
+func Test_Curry4(t *testing.T) {
+	join := Curry4(func(a string, b string, c string, d string) string {
+		return a + b + c + d
+	})
+	if join("g")("o")("f")("p") != "gofp" {
+		t.Errorf("Curry4() = %v, want %v", join("g")("o")("f")("p"), "gofp")
+	}
+}
+
+func Test_Uncurry2(t *testing.T) {
+	subtract := func(a int, b int) int { return a - b }
+	got := Uncurry2(Curry2(subtract))(10, 4)
+	if got != 6 {
+		t.Errorf("Uncurry2() = %v, want %v", got, 6)
+	}
+}
+
+func Test_Uncurry3(t *testing.T) {
+	volume := func(a int, b int, c int) int { return a * b * c }
+	got := Uncurry3(Curry3(volume))(2, 3, 4)
+	if got != 24 {
+		t.Errorf("Uncurry3() = %v, want %v", got, 24)
+	}
+}
+
+func Test_Uncurry4(t *testing.T) {
+	sum := func(a int, b int, c int, d int) int { return a + b + c + d }
+	got := Uncurry4(Curry4(sum))(1, 2, 3, 4)
+	if got != 10 {
+		t.Errorf("Uncurry4() = %v, want %v", got, 10)
+	}
+}
+
+func Test_Partial(t *testing.T) {
+	isOlderThan := func(min int, person interface{}) bool {
+		return person.(map[string]interface{})["age"].(int) > min
+	}
+	isAdult := Partial(isOlderThan, 17)
+	adult := Find([]interface{}{
+		map[string]interface{}{"name": "Ron", "age": 17},
+		map[string]interface{}{"name": "Raymond", "age": 20},
+	}, func(i int, person interface{}) bool {
+		return isAdult(person)
+	})
+	if adult.(map[string]interface{})["name"] != "Raymond" {
+		t.Errorf("Partial() = %v, want %v", adult.(map[string]interface{})["name"], "Raymond")
+	}
+}
+
+func Test_PartialRight(t *testing.T) {
+	divide := func(a float64, b float64) float64 { return a / b }
+	half := PartialRight(divide, 2)
+	if half(10) != 5 {
+		t.Errorf("PartialRight() = %v, want %v", half(10), 5)
+	}
+}
+
+func Test_Flip(t *testing.T) {
+	subtract := func(a int, b int) int { return a - b }
+	if Flip(subtract)(3, 10) != 7 {
+		t.Errorf("Flip() = %v, want %v", Flip(subtract)(3, 10), 7)
+	}
+}
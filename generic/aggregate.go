@@ -0,0 +1,79 @@
+package generic
+
+import "cmp"
+
+// Number is satisfied by every integer and floating point type
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// CountBy returns a map of the number of items per key returned from the function
+func CountBy[T any, K comparable](items []T, fn func(item T) K) map[K]int {
+	counts := map[K]int{}
+	for _, item := range items {
+		counts[fn(item)]++
+	}
+	return counts
+}
+
+// SumBy returns a map of the sum of the values returned from valueFn per key returned from keyFn
+func SumBy[T any, K comparable, N Number](items []T, keyFn func(item T) K, valueFn func(item T) N) map[K]N {
+	sums := map[K]N{}
+	for _, item := range items {
+		sums[keyFn(item)] += valueFn(item)
+	}
+	return sums
+}
+
+// AverageBy returns a map of the mean of the values returned from valueFn per key returned from keyFn
+func AverageBy[T any, K comparable, N Number](items []T, keyFn func(item T) K, valueFn func(item T) N) map[K]float64 {
+	sums, counts := map[K]float64{}, map[K]int{}
+	for _, item := range items {
+		key := keyFn(item)
+		sums[key] += float64(valueFn(item))
+		counts[key]++
+	}
+	for key, count := range counts {
+		sums[key] /= float64(count)
+	}
+	return sums
+}
+
+// GroupMinBy returns a map of the first item with the smallest value returned from valueFn per key returned from keyFn
+func GroupMinBy[T any, K comparable, V cmp.Ordered](items []T, keyFn func(item T) K, valueFn func(item T) V) map[K]T {
+	result := map[K]T{}
+	for key, group := range GroupBy(items, keyFn) {
+		result[key], _ = MinBy(group, valueFn)
+	}
+	return result
+}
+
+// GroupMaxBy returns a map of the first item with the largest value returned from valueFn per key returned from keyFn
+func GroupMaxBy[T any, K comparable, V cmp.Ordered](items []T, keyFn func(item T) K, valueFn func(item T) V) map[K]T {
+	result := map[K]T{}
+	for key, group := range GroupBy(items, keyFn) {
+		result[key], _ = MaxBy(group, valueFn)
+	}
+	return result
+}
+
+// KeyBy returns a map of items indexed by the key returned from the function, later items win when keys repeat
+func KeyBy[T any, K comparable](items []T, fn func(item T) K) map[K]T {
+	indexed := make(map[K]T, len(items))
+	for _, item := range items {
+		indexed[fn(item)] = item
+	}
+	return indexed
+}
+
+// AggregateBy groups the items by the key returned from keyFn and reduces every group with fn like Reduce does,
+// the group is passed to fn as the source
+func AggregateBy[T any, K comparable, A any](items []T, keyFn func(item T) K, fn func(index int, current T, accumulator A, source []T) A, initialValue A) map[K]A {
+	result := map[K]A{}
+	for key, group := range GroupBy(items, keyFn) {
+		result[key] = Reduce(group, fn, initialValue)
+	}
+	return result
+}
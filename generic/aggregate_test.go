@@ -0,0 +1,72 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+)
+
+type sale struct {
+	region  string
+	product string
+	amount  int
+}
+
+var sales = []sale{
+	{"eu", "book", 10},
+	{"us", "pen", 2},
+	{"eu", "pen", 4},
+	{"eu", "lamp", 25},
+}
+
+func region(s sale) string { return s.region }
+
+func amount(s sale) int { return s.amount }
+
+func Test_CountBy(t *testing.T) {
+	counts := CountBy(people, byAge)
+	if want := map[int]int{17: 1, 20: 2, 30: 1}; !reflect.DeepEqual(counts, want) {
+		t.Errorf("CountBy() = %v, want %v", counts, want)
+	}
+}
+
+func Test_SumBy(t *testing.T) {
+	sums := SumBy(sales, region, amount)
+	if want := map[string]int{"eu": 39, "us": 2}; !reflect.DeepEqual(sums, want) {
+		t.Errorf("SumBy() = %v, want %v", sums, want)
+	}
+}
+
+func Test_AverageBy(t *testing.T) {
+	averages := AverageBy(sales, region, amount)
+	if want := map[string]float64{"eu": 13, "us": 2}; !reflect.DeepEqual(averages, want) {
+		t.Errorf("AverageBy() = %v, want %v", averages, want)
+	}
+}
+
+func Test_GroupMinMaxBy(t *testing.T) {
+	cheapest := GroupMinBy(sales, region, amount)
+	if want := map[string]sale{"eu": sales[2], "us": sales[1]}; !reflect.DeepEqual(cheapest, want) {
+		t.Errorf("GroupMinBy() = %v, want %v", cheapest, want)
+	}
+	priciest := GroupMaxBy(sales, region, amount)
+	if want := map[string]sale{"eu": sales[3], "us": sales[1]}; !reflect.DeepEqual(priciest, want) {
+		t.Errorf("GroupMaxBy() = %v, want %v", priciest, want)
+	}
+}
+
+func Test_KeyBy(t *testing.T) {
+	indexed := KeyBy(people, byName)
+	if len(indexed) != 4 || indexed["Sofia"] != people[2] {
+		t.Errorf("KeyBy() = %v, want %v for Sofia", indexed["Sofia"], people[2])
+	}
+}
+
+func Test_AggregateBy(t *testing.T) {
+	products := AggregateBy(sales, region, func(_ int, current sale, accumulator []string, _ []sale) []string {
+		return append(accumulator, current.product)
+	}, nil)
+	want := map[string][]string{"eu": {"book", "pen", "lamp"}, "us": {"pen"}}
+	if !reflect.DeepEqual(products, want) {
+		t.Errorf("AggregateBy() = %v, want %v", products, want)
+	}
+}
@@ -0,0 +1,258 @@
+// Package generic provides type-safe counterparts of the gofp collection
+// functions. They accept and return typed slices so callers don't need to
+// convert to and from []interface{} or assert the results.
+package generic
+
+import (
+	"reflect"
+
+	"github.com/rbrahul/gofp/random"
+)
+
+// Map returns a new slice with transformed elements
+func Map[T, U any](items []T, fn func(index int, item T) U) []U {
+	mappedItems := make([]U, 0, len(items))
+	for index, value := range items {
+		mappedItems = append(mappedItems, fn(index, value))
+	}
+	return mappedItems
+}
+
+// Fill substitutes the elements of slice with filler from the start to end position
+func Fill[T any](items []T, filler T, start int, end int) []T {
+	newItems := make([]T, 0, len(items))
+	for index, value := range items {
+		if index >= start && index < end {
+			newItems = append(newItems, filler)
+			continue
+		}
+		newItems = append(newItems, value)
+	}
+	return newItems
+}
+
+// Filter returns a new slice of items which satisfies the condition
+func Filter[T any](items []T, fn func(index int, item T) bool) []T {
+	filteredItems := []T{}
+	for index, value := range items {
+		if fn(index, value) {
+			filteredItems = append(filteredItems, value)
+		}
+	}
+	return filteredItems
+}
+
+// Reduce iterate overs all the items in the slice and returns accumulated result
+func Reduce[T, A any](items []T, fn func(index int, current T, accumulator A, source []T) A, initialValue A) A {
+	accumulator := initialValue
+	for index, value := range items {
+		accumulator = fn(index, value, accumulator, items)
+	}
+	return accumulator
+}
+
+// Every returns true if all the items satisfies the given condition with the function
+func Every[T any](items []T, fn func(index int, item T) bool) bool {
+	for index, value := range items {
+		if !fn(index, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any returns true if any of the item satisfies the given condition with the function
+func Any[T any](items []T, fn func(index int, item T) bool) bool {
+	for index, value := range items {
+		if fn(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Find returns the first item which satisfies the given condition, ok is false if there is no such item
+func Find[T any](items []T, fn func(index int, item T) bool) (item T, ok bool) {
+	for index, value := range items {
+		if fn(index, value) {
+			return value, true
+		}
+	}
+	return item, false
+}
+
+// GroupBy returns a map of items grouped by the key returned from the function
+func GroupBy[T any, K comparable](items []T, fn func(item T) K) map[K][]T {
+	group := map[K][]T{}
+	for _, value := range items {
+		key := fn(value)
+		group[key] = append(group[key], value)
+	}
+	return group
+}
+
+// Head returns the first item of slice, ok is false if the slice is empty
+func Head[T any](items []T) (item T, ok bool) {
+	if len(items) >= 1 {
+		return items[0], true
+	}
+	return item, false
+}
+
+// Tail returns the last item of slice, ok is false if the slice is empty
+func Tail[T any](items []T) (item T, ok bool) {
+	if len(items) >= 1 {
+		return items[len(items)-1], true
+	}
+	return item, false
+}
+
+// Reverse returns a new slice of reversed items
+func Reverse[T any](items []T) []T {
+	reversed := make([]T, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		reversed = append(reversed, items[i])
+	}
+	return reversed
+}
+
+// Chunk returns a new slice(chunks) of slices. Every slice has at most size number of elements, it
+// panics if the size isn't greater than 0
+func Chunk[T any](items []T, size int) [][]T {
+	if size <= 0 {
+		panic("Invalid chunk size, size must be greater than 0")
+	}
+	chunks := [][]T{}
+	if len(items) == 0 {
+		return chunks
+	}
+	if size >= len(items) {
+		return append(chunks, items)
+	}
+	for startAt := 0; startAt < len(items); startAt += size {
+		upperLimit := startAt + size
+		if upperLimit > len(items) {
+			upperLimit = len(items)
+		}
+		chunks = append(chunks, items[startAt:upperLimit])
+	}
+	return chunks
+}
+
+// Uniq returns a new slice of unique items preserving the order of first occurrence
+func Uniq[T comparable](items []T) []T {
+	seen := make(map[T]struct{}, len(items))
+	uniqueItems := []T{}
+	for _, item := range items {
+		if _, exists := seen[item]; !exists {
+			seen[item] = struct{}{}
+			uniqueItems = append(uniqueItems, item)
+		}
+	}
+	return uniqueItems
+}
+
+// IndexOf returns the poisition of the item in a slice, if item doesn't exist returns -1 otherwise
+func IndexOf[T comparable](items []T, item T) int {
+	for index, value := range items {
+		if value == item {
+			return index
+		}
+	}
+	return -1
+}
+
+// Contains returns true if item exists in the slice and false otherwise
+func Contains[T comparable](items []T, item T) bool {
+	return IndexOf(items, item) > -1
+}
+
+// UniqBy returns a new slice of items with unique keys returned from the function, keeping the first item of each key
+func UniqBy[T any, K comparable](items []T, fn func(item T) K) []T {
+	seen := make(map[K]struct{}, len(items))
+	uniqueItems := []T{}
+	for _, item := range items {
+		key := fn(item)
+		if _, exists := seen[key]; !exists {
+			seen[key] = struct{}{}
+			uniqueItems = append(uniqueItems, item)
+		}
+	}
+	return uniqueItems
+}
+
+// UniqWith returns a new slice of unique items where equality is decided by the function
+func UniqWith[T any](items []T, equal func(a T, b T) bool) []T {
+	uniqueItems := []T{}
+	for _, item := range items {
+		if !ContainsFunc(uniqueItems, func(_ int, unique T) bool { return equal(unique, item) }) {
+			uniqueItems = append(uniqueItems, item)
+		}
+	}
+	return uniqueItems
+}
+
+// UniqDeep returns a new slice of unique items compared with reflect.DeepEqual
+func UniqDeep[T any](items []T) []T {
+	return UniqWith(items, func(a T, b T) bool { return reflect.DeepEqual(a, b) })
+}
+
+// IndexOfFunc returns the position of the first item which satisfies the condition, -1 if there is no such item
+func IndexOfFunc[T any](items []T, fn func(index int, item T) bool) int {
+	for index, value := range items {
+		if fn(index, value) {
+			return index
+		}
+	}
+	return -1
+}
+
+// LastIndexOf returns the position of the last occurrence of the item in a slice, -1 if item doesn't exist
+func LastIndexOf[T comparable](items []T, item T) int {
+	return LastIndexOfFunc(items, func(_ int, value T) bool { return value == item })
+}
+
+// LastIndexOfFunc returns the position of the last item which satisfies the condition, -1 if there is no such item
+func LastIndexOfFunc[T any](items []T, fn func(index int, item T) bool) int {
+	for index := len(items) - 1; index >= 0; index-- {
+		if fn(index, items[index]) {
+			return index
+		}
+	}
+	return -1
+}
+
+// IndexOfDeep returns the position of the first item equal to item according to reflect.DeepEqual, -1 if item doesn't exist
+func IndexOfDeep[T any](items []T, item T) int {
+	return IndexOfFunc(items, func(_ int, value T) bool { return reflect.DeepEqual(value, item) })
+}
+
+// ContainsFunc returns true if any item satisfies the condition
+func ContainsFunc[T any](items []T, fn func(index int, item T) bool) bool {
+	return IndexOfFunc(items, fn) > -1
+}
+
+// ContainsDeep returns true if an item equal to item according to reflect.DeepEqual exists in the slice
+func ContainsDeep[T any](items []T, item T) bool {
+	return IndexOfDeep(items, item) > -1
+}
+
+// Shuffle returns a new slice with shuffled elements, every order is equally likely
+func Shuffle[T any](items []T, opts ...random.Option) []T {
+	shuffled := make([]T, len(items))
+	copy(shuffled, items)
+	source := random.SourceOf(opts...)
+	for i := len(shuffled) - 1; i > 0; i-- {
+		j := source.Intn(i + 1)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+	return shuffled
+}
+
+// ChooseRandom returns a random element from the slice, or the zero value if the slice is empty
+func ChooseRandom[T any](items []T, opts ...random.Option) (item T) {
+	if len(items) == 0 {
+		return item
+	}
+	return items[random.SourceOf(opts...).Intn(len(items))]
+}
@@ -0,0 +1,246 @@
+package generic
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+var people = []person{
+	{name: "Ron", age: 17},
+	{name: "Raymond", age: 20},
+	{name: "Sofia", age: 20},
+	{name: "Roni", age: 30},
+}
+
+func Test_Map(t *testing.T) {
+	got := Map([]int{1, 2, 3, 4, 5}, func(i int, item int) string {
+		return strconv.Itoa(item * item)
+	})
+	want := []string{"1", "4", "9", "16", "25"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Map() = %v, want %v", got, want)
+	}
+}
+
+func Test_Fill(t *testing.T) {
+	got := Fill([]string{"a", "b", "c", "d", "e"}, "*", 1, 4)
+	want := []string{"a", "*", "*", "*", "e"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fill() = %v, want %v", got, want)
+	}
+}
+
+func Test_Filter(t *testing.T) {
+	got := Filter([]int{12, 16, 18, 20, 23, 40, 25}, func(i int, age int) bool {
+		return age >= 20
+	})
+	want := []int{20, 23, 40, 25}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func Test_Reduce(t *testing.T) {
+	got := Reduce([]int{10, 20, 30, 40}, func(index int, current int, accumulator float64, source []int) float64 {
+		return accumulator + float64(current)/2
+	}, 0.0)
+	if got != 50 {
+		t.Errorf("Reduce() = %v, want %v", got, 50)
+	}
+}
+
+func Test_Every(t *testing.T) {
+	isEveryOneIsAdult := Every([]int{18, 20, 23, 40, 25}, func(i int, age int) bool {
+		return age >= 18
+	})
+	if !isEveryOneIsAdult {
+		t.Errorf("Every() = %v, want %v", isEveryOneIsAdult, true)
+	}
+}
+
+func Test_Any(t *testing.T) {
+	isAnyoneFortieth := Any([]int{18, 20, 23, 40, 25}, func(i int, age int) bool {
+		return age >= 40
+	})
+	if !isAnyoneFortieth {
+		t.Errorf("Any() = %v, want %v", isAnyoneFortieth, true)
+	}
+}
+
+func Test_Find(t *testing.T) {
+	firstAdult, ok := Find(people, func(i int, p person) bool {
+		return p.age >= 18
+	})
+	if !ok || firstAdult.name != "Raymond" {
+		t.Errorf("Find() = %v, want %v", firstAdult.name, "Raymond")
+	}
+	_, ok = Find(people, func(i int, p person) bool {
+		return p.age > 100
+	})
+	if ok {
+		t.Errorf("Find() ok = %v, want %v", ok, false)
+	}
+}
+
+func Test_GroupBy(t *testing.T) {
+	groupedData := GroupBy(people, func(p person) int {
+		return p.age
+	})
+	if len(groupedData[20]) != 2 {
+		t.Errorf("GroupBy() = %v, want %v", len(groupedData[20]), 2)
+	}
+}
+
+func Test_Head(t *testing.T) {
+	firstElement, ok := Head([]int{10, 20, 30, 40, 50})
+	if !ok || firstElement != 10 {
+		t.Errorf("Head() = %v, want %v", firstElement, 10)
+	}
+	if _, ok := Head([]int{}); ok {
+		t.Errorf("Head() ok = %v, want %v", ok, false)
+	}
+}
+
+func Test_Tail(t *testing.T) {
+	lastElement, ok := Tail([]int{10, 20, 30, 40, 50})
+	if !ok || lastElement != 50 {
+		t.Errorf("Tail() = %v, want %v", lastElement, 50)
+	}
+	if _, ok := Tail([]int{}); ok {
+		t.Errorf("Tail() ok = %v, want %v", ok, false)
+	}
+}
+
+func Test_Reverse(t *testing.T) {
+	got := Reverse([]int{10, 20, 30, 40, 50})
+	want := []int{50, 40, 30, 20, 10}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse() = %v, want %v", got, want)
+	}
+}
+
+func Test_Chunk(t *testing.T) {
+	got := Chunk([]int{1, 2, 3, 4, 5}, 2)
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunk() = %v, want %v", got, want)
+	}
+	got = Chunk([]int{1, 2, 3, 4}, 2)
+	want = [][]int{{1, 2}, {3, 4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunk() = %v, want %v", got, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with size 0 didn't panic")
+		}
+	}()
+	Chunk([]int{1, 2}, 0)
+}
+
+func Test_Uniq(t *testing.T) {
+	got := Uniq([]int{1, 2, 2, 3, 10, 4, 5, 10, 100})
+	want := []int{1, 2, 3, 10, 4, 5, 100}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Uniq() = %v, want %v", got, want)
+	}
+}
+
+func Test_IndexOf(t *testing.T) {
+	index := IndexOf([]int{1, 2, 2, 3, 10, 4, 5, 10, 100}, 10)
+	if index != 4 {
+		t.Errorf("IndexOf() = %v, want %v", index, 4)
+	}
+}
+
+func Test_Contains(t *testing.T) {
+	exists := Contains([]string{"a", "b", "c"}, "c")
+	if !exists {
+		t.Errorf("Contains() = %v, want %v", exists, true)
+	}
+}
+
+func Test_UniqBy(t *testing.T) {
+	got := UniqBy(people, func(p person) int { return p.age })
+	want := []person{people[0], people[1], people[3]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqBy() = %v, want %v", got, want)
+	}
+}
+
+func Test_UniqWith(t *testing.T) {
+	got := UniqWith([]string{"Go", "go", "Rust", "GO"}, strings.EqualFold)
+	want := []string{"Go", "Rust"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqWith() = %v, want %v", got, want)
+	}
+}
+
+func Test_UniqDeep(t *testing.T) {
+	records := []map[string]interface{}{{"id": 1}, {"id": 2}, {"id": 1}}
+	got := UniqDeep(records)
+	want := records[:2]
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UniqDeep() = %v, want %v", got, want)
+	}
+}
+
+func Test_IndexOfFunc(t *testing.T) {
+	index := IndexOfFunc(people, func(i int, p person) bool { return p.age == 20 })
+	if index != 1 {
+		t.Errorf("IndexOfFunc() = %v, want %v", index, 1)
+	}
+	index = IndexOfFunc(people, func(i int, p person) bool { return p.age > 50 })
+	if index != -1 {
+		t.Errorf("IndexOfFunc() = %v, want %v", index, -1)
+	}
+}
+
+func Test_LastIndexOf(t *testing.T) {
+	index := LastIndexOf([]int{1, 2, 2, 3, 10, 4, 5, 10, 100}, 10)
+	if index != 7 {
+		t.Errorf("LastIndexOf() = %v, want %v", index, 7)
+	}
+	index = LastIndexOfFunc(people, func(i int, p person) bool { return p.age == 20 })
+	if index != 2 {
+		t.Errorf("LastIndexOfFunc() = %v, want %v", index, 2)
+	}
+}
+
+func Test_ContainsDeep(t *testing.T) {
+	items := [][]int{{1, 2}, {3}}
+	if !ContainsDeep(items, []int{3}) || ContainsDeep(items, []int{2}) {
+		t.Errorf("ContainsDeep() = %v, want %v", ContainsDeep(items, []int{2}), false)
+	}
+	if IndexOfDeep(items, []int{1, 2}) != 0 {
+		t.Errorf("IndexOfDeep() = %v, want %v", IndexOfDeep(items, []int{1, 2}), 0)
+	}
+	if !ContainsFunc(people, func(i int, p person) bool { return p.name == "Sofia" }) {
+		t.Errorf("ContainsFunc() = %v, want %v", false, true)
+	}
+}
+
+func Test_Shuffle(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	shuffledItems := Shuffle(items)
+	if len(shuffledItems) != len(items) || !Every(items, func(i int, item int) bool {
+		return Contains(shuffledItems, item)
+	}) {
+		t.Errorf("Shuffle() = %v, want a permutation of %v", shuffledItems, items)
+	}
+}
+
+func Test_ChooseRandom(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	item := ChooseRandom(items)
+	if !Contains(items, item) {
+		t.Errorf("ChooseRandom() = %v, want %v", Contains(items, item), true)
+	}
+}
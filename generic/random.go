@@ -0,0 +1,52 @@
+package generic
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/rbrahul/gofp/random"
+)
+
+// Sample returns a new slice of n items picked at random without replacement, all items if n exceeds the length
+func Sample[T any](items []T, n int, opts ...random.Option) []T {
+	if n < 0 {
+		panic("Invalid sample size " + strconv.Itoa(n) + ", it must not be negative")
+	}
+	source := random.SourceOf(opts...)
+	sampled := append([]T{}, items...)
+	n = min(n, len(sampled))
+	for i := 0; i < n; i++ {
+		j := i + source.Intn(len(sampled)-i)
+		sampled[i], sampled[j] = sampled[j], sampled[i]
+	}
+	return sampled[:n:n]
+}
+
+// SampleWeighted returns a new slice of up to n items picked at random without replacement. The chance of
+// an item is proportional to the weight returned from the function, items weighing 0 are never picked
+func SampleWeighted[T any](items []T, n int, fn func(index int, item T) float64, opts ...random.Option) []T {
+	if n < 0 {
+		panic("Invalid sample size " + strconv.Itoa(n) + ", it must not be negative")
+	}
+	weights, _ := random.Weights(items, fn)
+	source := random.SourceOf(opts...)
+	type weighted struct {
+		key  float64
+		item T
+	}
+	keys := []weighted{}
+	for index, w := range weights {
+		if w > 0 {
+			// Efraimidis–Spirakis: the n largest keys form a weighted sample without replacement
+			keys = append(keys, weighted{key: math.Log(1-source.Float64()) / w, item: items[index]})
+		}
+	}
+	best := selectBest(keys, n, func(a, b weighted) bool {
+		return a.key > b.key
+	})
+	sampled := make([]T, len(best))
+	for i, entry := range best {
+		sampled[i] = entry.item
+	}
+	return sampled
+}
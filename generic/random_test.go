@@ -0,0 +1,49 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rbrahul/gofp/random"
+)
+
+func Test_ShuffleWithSeed(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	first, second := Shuffle(items, random.WithSeed(9)), Shuffle(items, random.WithSeed(9))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Shuffle() with the same seed = %v and %v, want equal results", first, second)
+	}
+	if ChooseRandom([]string{}, random.WithSeed(1)) != "" {
+		t.Errorf("ChooseRandom() = %q, want the zero value", ChooseRandom([]string{}))
+	}
+}
+
+func Test_Sample(t *testing.T) {
+	sampled := Sample(people, 2, random.WithSeed(4))
+	if len(sampled) != 2 || len(UniqBy(sampled, byName)) != 2 {
+		t.Errorf("Sample() = %v, want %v distinct people", sampled, 2)
+	}
+	if sampled := Sample(people, 0); len(sampled) != 0 {
+		t.Errorf("Sample() = %v, want %v", sampled, []person{})
+	}
+}
+
+func Test_SampleWeighted(t *testing.T) {
+	option := random.WithSeed(3)
+	adults := 0
+	for i := 0; i < 200; i++ {
+		sampled := SampleWeighted(people, 2, func(_ int, p person) float64 {
+			if p.age < 18 {
+				return 0
+			}
+			return 1
+		}, option)
+		if len(sampled) != 2 || sampled[0] == sampled[1] {
+			t.Fatalf("SampleWeighted() = %v, want %v distinct people", sampled, 2)
+		}
+		adults += CountBy(sampled, func(p person) bool { return p.age >= 18 })[true]
+	}
+	if adults != 400 {
+		t.Errorf("SampleWeighted() picked %v adults, want %v", adults, 400)
+	}
+}
@@ -0,0 +1,185 @@
+package generic
+
+import "iter"
+
+// Set is an unordered collection of unique items
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a Set holding the given items
+func NewSet[T comparable](items ...T) Set[T] {
+	set := make(Set[T], len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// SetFromSlice returns a Set holding the items of the slice
+func SetFromSlice[T comparable](items []T) Set[T] {
+	return NewSet(items...)
+}
+
+// Add adds the items to the Set
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove removes the items from the Set
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Has returns true if the item exists in the Set
+func (s Set[T]) Has(item T) bool {
+	_, exists := s[item]
+	return exists
+}
+
+// Len returns the number of items in the Set
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// All returns a sequence of the items of the Set in no particular order
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the items of the Set in no particular order
+func (s Set[T]) ToSlice() []T {
+	items := make([]T, 0, len(s))
+	for item := range s {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Clone returns a copy of the Set
+func (s Set[T]) Clone() Set[T] {
+	clone := make(Set[T], len(s))
+	for item := range s {
+		clone[item] = struct{}{}
+	}
+	return clone
+}
+
+// Union returns a new Set with the items which exist in either Set
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	union := s.Clone()
+	for item := range other {
+		union[item] = struct{}{}
+	}
+	return union
+}
+
+// Intersect returns a new Set with the items which exist in both Sets
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	smaller, larger := s, other
+	if len(larger) < len(smaller) {
+		smaller, larger = larger, smaller
+	}
+	intersection := Set[T]{}
+	for item := range smaller {
+		if larger.Has(item) {
+			intersection[item] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new Set with the items which don't exist in the other Set
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	difference := Set[T]{}
+	for item := range s {
+		if !other.Has(item) {
+			difference[item] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// SymmetricDifference returns a new Set with the items which exist in exactly one of the Sets
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	difference := s.Difference(other)
+	for item := range other {
+		if !s.Has(item) {
+			difference[item] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// IsSubset returns true if every item of the Set exists in the other Set
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for item := range s {
+		if !other.Has(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every item of the other Set exists in the Set
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal returns true if both Sets hold the same items
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// Union returns a new slice of the unique items of both slices in the order of first occurrence
+func Union[T comparable](a []T, b []T) []T {
+	seen := make(Set[T], len(a)+len(b))
+	union := []T{}
+	for _, items := range [][]T{a, b} {
+		for _, item := range items {
+			if !seen.Has(item) {
+				seen[item] = struct{}{}
+				union = append(union, item)
+			}
+		}
+	}
+	return union
+}
+
+// Intersection returns a new slice of the unique items of a which exist in b, in the order of a
+func Intersection[T comparable](a []T, b []T) []T {
+	other := SetFromSlice(b)
+	seen := Set[T]{}
+	intersection := []T{}
+	for _, item := range a {
+		if other.Has(item) && !seen.Has(item) {
+			seen[item] = struct{}{}
+			intersection = append(intersection, item)
+		}
+	}
+	return intersection
+}
+
+// Difference returns a new slice of the unique items of a which don't exist in b, in the order of a
+func Difference[T comparable](a []T, b []T) []T {
+	excluded := SetFromSlice(b)
+	difference := []T{}
+	for _, item := range a {
+		if !excluded.Has(item) {
+			excluded[item] = struct{}{}
+			difference = append(difference, item)
+		}
+	}
+	return difference
+}
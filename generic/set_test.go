@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func sorted(items []int) []int {
+	sort.Ints(items)
+	return items
+}
+
+func Test_NewSet(t *testing.T) {
+	set := NewSet(1, 2, 2, 3)
+	if set.Len() != 3 || !set.Has(2) || set.Has(4) {
+		t.Errorf("NewSet() = %v, want %v", set, []int{1, 2, 3})
+	}
+}
+
+func Test_SetFromSlice(t *testing.T) {
+	set := SetFromSlice([]string{"a", "b", "a"})
+	if got := set.ToSlice(); len(got) != 2 {
+		t.Errorf("SetFromSlice() = %v, want %v items", got, 2)
+	}
+}
+
+func Test_SetAddRemove(t *testing.T) {
+	set := NewSet[int]()
+	set.Add(1, 2, 3)
+	set.Remove(2, 5)
+	if got := sorted(set.ToSlice()); !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("Add()/Remove() = %v, want %v", got, []int{1, 3})
+	}
+}
+
+func Test_SetAll(t *testing.T) {
+	total := 0
+	for item := range NewSet(1, 2, 3).All() {
+		total += item
+	}
+	if total != 6 {
+		t.Errorf("All() total = %v, want %v", total, 6)
+	}
+}
+
+func Test_SetClone(t *testing.T) {
+	set := NewSet(1, 2)
+	clone := set.Clone()
+	clone.Add(3)
+	if set.Has(3) || !clone.Equal(NewSet(1, 2, 3)) {
+		t.Errorf("Clone() = %v, want an independent copy of %v", clone, set)
+	}
+}
+
+func Test_SetOperations(t *testing.T) {
+	a := NewSet(1, 2, 3, 4)
+	b := NewSet(3, 4, 5)
+	tests := []struct {
+		name string
+		got  Set[int]
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1, 2, 3, 4, 5}},
+		{"Intersect", a.Intersect(b), []int{3, 4}},
+		{"Difference", a.Difference(b), []int{1, 2}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1, 2, 5}},
+	}
+	for _, tt := range tests {
+		if got := sorted(tt.got.ToSlice()); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if a.Len() != 4 || b.Len() != 3 {
+		t.Errorf("Set operations modified the operands: %v, %v", a, b)
+	}
+}
+
+func Test_SetIsSubset(t *testing.T) {
+	if !NewSet(1, 2).IsSubset(NewSet(1, 2, 3)) || NewSet(1, 4).IsSubset(NewSet(1, 2, 3)) || !NewSet(1, 2, 3).IsSuperset(NewSet(2)) {
+		t.Errorf("IsSubset() = %v, want %v", NewSet(1, 4).IsSubset(NewSet(1, 2, 3)), false)
+	}
+}
+
+func Test_Union(t *testing.T) {
+	got := Union([]int{3, 1, 3}, []int{2, 1, 4})
+	if want := []int{3, 1, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+}
+
+func Test_Intersection(t *testing.T) {
+	got := Intersection([]string{"c", "a", "b", "a"}, []string{"a", "c"})
+	if want := []string{"c", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersection() = %v, want %v", got, want)
+	}
+}
+
+func Test_Difference(t *testing.T) {
+	got := Difference([]int{5, 1, 2, 5, 3}, []int{2})
+	if want := []int{5, 1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Difference() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,224 @@
+package generic
+
+import (
+	"cmp"
+	"container/heap"
+	"slices"
+)
+
+// OrderKey is a sort key of OrderBy, created with Asc or Desc
+type OrderKey[T any] struct {
+	compare func(a T, b T) int
+}
+
+// Asc returns an OrderKey which sorts by the key returned from the function in ascending order
+func Asc[T any, K cmp.Ordered](fn func(item T) K) OrderKey[T] {
+	return OrderKey[T]{compare: func(a T, b T) int {
+		return cmp.Compare(fn(a), fn(b))
+	}}
+}
+
+// Desc returns an OrderKey which sorts by the key returned from the function in descending order
+func Desc[T any, K cmp.Ordered](fn func(item T) K) OrderKey[T] {
+	return OrderKey[T]{compare: func(a T, b T) int {
+		return cmp.Compare(fn(b), fn(a))
+	}}
+}
+
+type keyed[T any, K cmp.Ordered] struct {
+	key  K
+	item T
+}
+
+func keyItems[T any, K cmp.Ordered](items []T, fn func(item T) K) []keyed[T, K] {
+	entries := make([]keyed[T, K], len(items))
+	for i, item := range items {
+		entries[i] = keyed[T, K]{key: fn(item), item: item}
+	}
+	return entries
+}
+
+func itemsOf[T any, K cmp.Ordered](entries []keyed[T, K]) []T {
+	items := make([]T, len(entries))
+	for i, entry := range entries {
+		items[i] = entry.item
+	}
+	return items
+}
+
+func compareKeyed[T any, K cmp.Ordered](a keyed[T, K], b keyed[T, K]) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// SortBy returns a new slice sorted in ascending order by the key returned from the function
+func SortBy[T any, K cmp.Ordered](items []T, fn func(item T) K) []T {
+	entries := keyItems(items, fn)
+	slices.SortFunc(entries, compareKeyed)
+	return itemsOf(entries)
+}
+
+// StableSortBy works like SortBy and keeps the original order of items with equal keys
+func StableSortBy[T any, K cmp.Ordered](items []T, fn func(item T) K) []T {
+	entries := keyItems(items, fn)
+	slices.SortStableFunc(entries, compareKeyed)
+	return itemsOf(entries)
+}
+
+func lessToCompare[T any](less func(a T, b T) bool) func(a T, b T) int {
+	return func(a T, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		}
+		return 0
+	}
+}
+
+// SortWith returns a new slice sorted with the less function
+func SortWith[T any](items []T, less func(a T, b T) bool) []T {
+	sorted := slices.Clone(items)
+	slices.SortFunc(sorted, lessToCompare(less))
+	return sorted
+}
+
+// StableSortWith works like SortWith and keeps the original order of equal items
+func StableSortWith[T any](items []T, less func(a T, b T) bool) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, lessToCompare(less))
+	return sorted
+}
+
+// OrderBy returns a new slice sorted by the keys in turn, later keys break the ties of earlier ones.
+// The sort is stable
+func OrderBy[T any](items []T, keys ...OrderKey[T]) []T {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a T, b T) int {
+		for _, key := range keys {
+			if result := key.compare(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+	return sorted
+}
+
+// MinBy returns the first item with the smallest key returned from the function, ok is false if the slice is empty
+func MinBy[T any, K cmp.Ordered](items []T, fn func(item T) K) (item T, ok bool) {
+	return Head(BottomK(items, 1, fn))
+}
+
+// MaxBy returns the first item with the largest key returned from the function, ok is false if the slice is empty
+func MaxBy[T any, K cmp.Ordered](items []T, fn func(item T) K) (item T, ok bool) {
+	return Head(TopK(items, 1, fn))
+}
+
+// TopK returns a new slice of the k items with the largest keys, largest first. Items with equal keys
+// keep their original order
+func TopK[T any, K cmp.Ordered](items []T, k int, fn func(item T) K) []T {
+	return itemsOf(TopKFunc(keyItems(items, fn), k, compareKeyed))
+}
+
+// BottomK returns a new slice of the k items with the smallest keys, smallest first. Items with equal
+// keys keep their original order
+func BottomK[T any, K cmp.Ordered](items []T, k int, fn func(item T) K) []T {
+	return itemsOf(BottomKFunc(keyItems(items, fn), k, compareKeyed))
+}
+
+// TopKFunc returns a new slice of the k largest items according to the compare function, largest first.
+// Equal items keep their original order
+func TopKFunc[T any](items []T, k int, compare func(a T, b T) int) []T {
+	return rankItems(items, k, func(a T, b T) int {
+		return compare(b, a)
+	})
+}
+
+// BottomKFunc returns a new slice of the k smallest items according to the compare function, smallest
+// first. Equal items keep their original order
+func BottomKFunc[T any](items []T, k int, compare func(a T, b T) int) []T {
+	return rankItems(items, k, compare)
+}
+
+type ranked[T any] struct {
+	item  T
+	index int
+}
+
+// rankItems returns the first k items in the order of compare, equal items keep their original order
+func rankItems[T any](items []T, k int, compare func(a T, b T) int) []T {
+	entries := make([]ranked[T], len(items))
+	for i, item := range items {
+		entries[i] = ranked[T]{item: item, index: i}
+	}
+	best := selectBest(entries, k, func(a ranked[T], b ranked[T]) bool {
+		result := compare(a.item, b.item)
+		return result < 0 || result == 0 && a.index < b.index
+	})
+	ranking := make([]T, len(best))
+	for i, entry := range best {
+		ranking[i] = entry.item
+	}
+	return ranking
+}
+
+// IsSorted returns true if the items are in ascending order
+func IsSorted[T cmp.Ordered](items []T) bool {
+	return slices.IsSorted(items)
+}
+
+// IsSortedBy returns true if the keys returned from the function are in ascending order
+func IsSortedBy[T any, K cmp.Ordered](items []T, fn func(item T) K) bool {
+	return IsSortedWith(items, func(a T, b T) bool {
+		return cmp.Less(fn(a), fn(b))
+	})
+}
+
+// IsSortedWith returns true if the items are in order according to the less function
+func IsSortedWith[T any](items []T, less func(a T, b T) bool) bool {
+	for i := 1; i < len(items); i++ {
+		if less(items[i], items[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// rankHeap keeps the worst ranked entry on top so it can be replaced by a better one
+type rankHeap[E any] struct {
+	entries []E
+	better  func(a E, b E) bool
+}
+
+func (h *rankHeap[E]) Len() int           { return len(h.entries) }
+func (h *rankHeap[E]) Less(i, j int) bool { return h.better(h.entries[j], h.entries[i]) }
+func (h *rankHeap[E]) Swap(i, j int)      { h.entries[i], h.entries[j] = h.entries[j], h.entries[i] }
+func (h *rankHeap[E]) Push(x any)         { h.entries = append(h.entries, x.(E)) }
+
+func (h *rankHeap[E]) Pop() any {
+	last := h.entries[len(h.entries)-1]
+	h.entries = h.entries[:len(h.entries)-1]
+	return last
+}
+
+// selectBest returns the k best entries, best first, using a heap which never holds more than k entries
+func selectBest[E any](entries []E, k int, better func(a E, b E) bool) []E {
+	if k <= 0 {
+		return []E{}
+	}
+	h := &rankHeap[E]{entries: make([]E, 0, min(k, len(entries))), better: better}
+	for _, entry := range entries {
+		if h.Len() < k {
+			heap.Push(h, entry)
+		} else if better(entry, h.entries[0]) {
+			h.entries[0] = entry
+			heap.Fix(h, 0)
+		}
+	}
+	best := make([]E, h.Len())
+	for i := len(best) - 1; i >= 0; i-- {
+		best[i] = heap.Pop(h).(E)
+	}
+	return best
+}
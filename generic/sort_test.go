@@ -0,0 +1,99 @@
+package generic
+
+import (
+	"cmp"
+	"reflect"
+	"testing"
+)
+
+func names(items []person) []string {
+	return Map(items, func(_ int, p person) string { return p.name })
+}
+
+func byName(p person) string { return p.name }
+
+func byAge(p person) int { return p.age }
+
+func Test_SortBy(t *testing.T) {
+	sorted := names(SortBy(people, byName))
+	if want := []string{"Raymond", "Ron", "Roni", "Sofia"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("SortBy() = %v, want %v", sorted, want)
+	}
+	if people[0].name != "Ron" {
+		t.Errorf("SortBy() modified the source slice")
+	}
+}
+
+func Test_StableSortBy(t *testing.T) {
+	sorted := names(StableSortBy(people, func(p person) int { return -p.age }))
+	if want := []string{"Roni", "Raymond", "Sofia", "Ron"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("StableSortBy() = %v, want %v", sorted, want)
+	}
+}
+
+func Test_SortWith(t *testing.T) {
+	sorted := SortWith([]int{3, 1, 2}, func(a int, b int) bool { return a > b })
+	if want := []int{3, 2, 1}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("SortWith() = %v, want %v", sorted, want)
+	}
+	stable := names(StableSortWith(people, func(a person, b person) bool { return a.age < b.age }))
+	if want := []string{"Ron", "Raymond", "Sofia", "Roni"}; !reflect.DeepEqual(stable, want) {
+		t.Errorf("StableSortWith() = %v, want %v", stable, want)
+	}
+}
+
+func Test_OrderBy(t *testing.T) {
+	sorted := names(OrderBy(people, Desc(byAge), Asc(byName)))
+	if want := []string{"Roni", "Raymond", "Sofia", "Ron"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("OrderBy() = %v, want %v", sorted, want)
+	}
+}
+
+func Test_MinByMaxBy(t *testing.T) {
+	if youngest, ok := MinBy(people, byAge); !ok || youngest.name != "Ron" {
+		t.Errorf("MinBy() = %v, want %v", youngest, people[0])
+	}
+	if oldest, ok := MaxBy(people, byAge); !ok || oldest.name != "Roni" {
+		t.Errorf("MaxBy() = %v, want %v", oldest, people[3])
+	}
+	if _, ok := MaxBy([]person{}, byAge); ok {
+		t.Errorf("MaxBy() ok = %v, want %v", ok, false)
+	}
+}
+
+func Test_TopK(t *testing.T) {
+	identity := func(item int) int { return item }
+	items := []int{5, 1, 9, 3, 9, 7}
+	if top := TopK(items, 3, identity); !reflect.DeepEqual(top, []int{9, 9, 7}) {
+		t.Errorf("TopK() = %v, want %v", top, []int{9, 9, 7})
+	}
+	if bottom := BottomK(items, 2, identity); !reflect.DeepEqual(bottom, []int{1, 3}) {
+		t.Errorf("BottomK() = %v, want %v", bottom, []int{1, 3})
+	}
+	if top := TopK(items, 0, identity); len(top) != 0 {
+		t.Errorf("TopK() = %v, want %v", top, []int{})
+	}
+	if top := names(TopK(people, 2, byAge)); !reflect.DeepEqual(top, []string{"Roni", "Raymond"}) {
+		t.Errorf("TopK() = %v, want %v", top, []string{"Roni", "Raymond"})
+	}
+}
+
+func Test_TopKFunc(t *testing.T) {
+	byLength := func(a string, b string) int { return cmp.Compare(len(a), len(b)) }
+	words := []string{"go", "gopher", "fp", "lambda", "x"}
+	if top := TopKFunc(words, 2, byLength); !reflect.DeepEqual(top, []string{"gopher", "lambda"}) {
+		t.Errorf("TopKFunc() = %v, want %v", top, []string{"gopher", "lambda"})
+	}
+	if bottom := BottomKFunc(words, 3, byLength); !reflect.DeepEqual(bottom, []string{"x", "go", "fp"}) {
+		t.Errorf("BottomKFunc() = %v, want %v", bottom, []string{"x", "go", "fp"})
+	}
+}
+
+func Test_IsSorted(t *testing.T) {
+	if !IsSorted([]int{1, 2, 2, 3}) || IsSorted([]string{"b", "a"}) {
+		t.Errorf("IsSorted() = %v, want %v", IsSorted([]string{"b", "a"}), false)
+	}
+	if !IsSortedBy(people, byAge) || IsSortedBy(people, byName) {
+		t.Errorf("IsSortedBy() = %v, want %v", IsSortedBy(people, byName), false)
+	}
+}
@@ -0,0 +1,71 @@
+package generic
+
+import "strconv"
+
+// SlidingWindow returns a new slice of windows holding size consecutive items, a new window starts
+// every step items. Trailing items which don't fill a whole window are left out
+func SlidingWindow[T any](items []T, size int, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		panic("Invalid window size " + strconv.Itoa(size) + " or step " + strconv.Itoa(step) + ", both must be greater than 0")
+	}
+	windows := [][]T{}
+	for start := 0; start+size <= len(items); start += step {
+		windows = append(windows, append([]T{}, items[start:start+size]...))
+	}
+	return windows
+}
+
+// Partition returns a new slice of items which satisfy the condition and another one with the rest
+func Partition[T any](items []T, fn func(index int, item T) bool) ([]T, []T) {
+	matches, rest := []T{}, []T{}
+	for index, item := range items {
+		if fn(index, item) {
+			matches = append(matches, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	return matches, rest
+}
+
+// SplitAt returns new slices of the items before the index and from the index on. A negative index
+// counts from the end and out of range indexes are clamped
+func SplitAt[T any](items []T, index int) ([]T, []T) {
+	if index < 0 {
+		index += len(items)
+	}
+	index = min(max(index, 0), len(items))
+	return append([]T{}, items[:index]...), append([]T{}, items[index:]...)
+}
+
+// SplitWhen splits the items before the first item which satisfies the condition
+func SplitWhen[T any](items []T, fn func(index int, item T) bool) ([]T, []T) {
+	index := IndexOfFunc(items, fn)
+	if index < 0 {
+		index = len(items)
+	}
+	return SplitAt(items, index)
+}
+
+// ChunkBy returns a new slice of chunks, every chunk holds a run of consecutive items for which the
+// function returns the same key
+func ChunkBy[T any, K comparable](items []T, fn func(item T) K) [][]T {
+	return ChunkByFunc(items, fn, func(a K, b K) bool {
+		return a == b
+	})
+}
+
+// ChunkByFunc works like ChunkBy for keys of any type, equal decides whether two keys are the same
+func ChunkByFunc[T any, K any](items []T, fn func(item T) K, equal func(a K, b K) bool) [][]T {
+	chunks := [][]T{}
+	var lastKey K
+	for index, item := range items {
+		key := fn(item)
+		if index == 0 || !equal(key, lastKey) {
+			chunks = append(chunks, []T{})
+		}
+		chunks[len(chunks)-1] = append(chunks[len(chunks)-1], item)
+		lastKey = key
+	}
+	return chunks
+}
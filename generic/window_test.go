@@ -0,0 +1,53 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_SlidingWindow(t *testing.T) {
+	readings := []float64{1, 2, 3, 4, 5}
+	averages := Map(SlidingWindow(readings, 3, 1), func(_ int, window []float64) float64 {
+		return Reduce(window, func(_ int, current float64, sum float64, _ []float64) float64 {
+			return sum + current
+		}, 0) / float64(len(window))
+	})
+	if want := []float64{2, 3, 4}; !reflect.DeepEqual(averages, want) {
+		t.Errorf("SlidingWindow() averages = %v, want %v", averages, want)
+	}
+	if windows := SlidingWindow([]int{1, 2}, 3, 1); len(windows) != 0 {
+		t.Errorf("SlidingWindow() = %v, want %v", windows, [][]int{})
+	}
+}
+
+func Test_Partition(t *testing.T) {
+	adults, minors := Partition(people, func(_ int, p person) bool { return p.age >= 18 })
+	if len(adults) != 3 || !reflect.DeepEqual(minors, people[:1]) {
+		t.Errorf("Partition() = %v, %v, want %v, %v", adults, minors, people[1:], people[:1])
+	}
+}
+
+func Test_SplitAt(t *testing.T) {
+	before, after := SplitAt([]int{1, 2, 3}, -1)
+	if !reflect.DeepEqual(before, []int{1, 2}) || !reflect.DeepEqual(after, []int{3}) {
+		t.Errorf("SplitAt() = %v, %v, want %v, %v", before, after, []int{1, 2}, []int{3})
+	}
+}
+
+func Test_SplitWhen(t *testing.T) {
+	before, after := SplitWhen([]string{"a", "b", "", "c"}, func(_ int, item string) bool { return item == "" })
+	if !reflect.DeepEqual(before, []string{"a", "b"}) || !reflect.DeepEqual(after, []string{"", "c"}) {
+		t.Errorf("SplitWhen() = %v, %v, want %v, %v", before, after, []string{"a", "b"}, []string{"", "c"})
+	}
+	before, after = SplitWhen([]string{"a"}, func(_ int, item string) bool { return item == "" })
+	if !reflect.DeepEqual(before, []string{"a"}) || len(after) != 0 {
+		t.Errorf("SplitWhen() = %v, %v, want %v, %v", before, after, []string{"a"}, []string{})
+	}
+}
+
+func Test_ChunkBy(t *testing.T) {
+	chunks := ChunkBy(people, byAge)
+	if len(chunks) != 3 || len(chunks[1]) != 2 {
+		t.Errorf("ChunkBy() = %v, want %v chunks", chunks, 3)
+	}
+}
@@ -0,0 +1,137 @@
+package generic
+
+import "github.com/rbrahul/gofp/seq"
+
+// Zip returns a new slice of pairs holding the items at the same position of both slices, it stops at the shorter slice
+func Zip[T, U any](first []T, second []U) []seq.Pair[T, U] {
+	return ZipWith(first, second, func(a T, b U) seq.Pair[T, U] {
+		return seq.Pair[T, U]{First: a, Second: b}
+	})
+}
+
+// ZipLongest works like Zip but continues until the longer slice ends, the missing items are replaced by the fill values
+func ZipLongest[T, U any](first []T, second []U, firstFill T, secondFill U) []seq.Pair[T, U] {
+	zipped := make([]seq.Pair[T, U], max(len(first), len(second)))
+	for i := range zipped {
+		zipped[i] = seq.Pair[T, U]{First: firstFill, Second: secondFill}
+		if i < len(first) {
+			zipped[i].First = first[i]
+		}
+		if i < len(second) {
+			zipped[i].Second = second[i]
+		}
+	}
+	return zipped
+}
+
+// ZipWith returns a new slice of the values returned from the function called with the items at the same
+// position of both slices, it stops at the shorter slice
+func ZipWith[T, U, R any](first []T, second []U, fn func(a T, b U) R) []R {
+	results := make([]R, min(len(first), len(second)))
+	for i := range results {
+		results[i] = fn(first[i], second[i])
+	}
+	return results
+}
+
+// Unzip splits a slice of pairs into a slice of first and a slice of second items
+func Unzip[T, U any](pairs []seq.Pair[T, U]) ([]T, []U) {
+	first, second := make([]T, len(pairs)), make([]U, len(pairs))
+	for i, pair := range pairs {
+		first[i], second[i] = pair.First, pair.Second
+	}
+	return first, second
+}
+
+// zipSlices returns rows holding the items at the same position of every slice. It stops at the
+// shortest slice unless longest is true, then missing items are replaced by fill
+func zipSlices[T any](slices [][]T, longest bool, fill T) [][]T {
+	zipped := [][]T{}
+	if len(slices) == 0 {
+		return zipped
+	}
+	length := len(slices[0])
+	for _, items := range slices[1:] {
+		if longest {
+			length = max(length, len(items))
+		} else {
+			length = min(length, len(items))
+		}
+	}
+	for i := 0; i < length; i++ {
+		row := make([]T, len(slices))
+		for j, items := range slices {
+			if i < len(items) {
+				row[j] = items[i]
+			} else {
+				row[j] = fill
+			}
+		}
+		zipped = append(zipped, row)
+	}
+	return zipped
+}
+
+// ZipN returns a new slice of tuples holding the items at the same position of every slice, it stops at the shortest slice
+func ZipN[T any](slices ...[]T) [][]T {
+	var zero T
+	return zipSlices(slices, false, zero)
+}
+
+// ZipNLongest works like ZipN but continues until the longest slice ends, the missing items are replaced by fill
+func ZipNLongest[T any](fill T, slices ...[]T) [][]T {
+	return zipSlices(slices, true, fill)
+}
+
+// Transpose returns a new slice of slices whose rows are the columns of rows. Items missing from shorter rows are skipped
+func Transpose[T any](rows [][]T) [][]T {
+	columns := [][]T{}
+	for _, row := range rows {
+		for j, item := range row {
+			if j == len(columns) {
+				columns = append(columns, []T{})
+			}
+			columns[j] = append(columns[j], item)
+		}
+	}
+	return columns
+}
+
+// CartesianProduct returns a new slice of every combination taking one item of each slice, the last slice varies fastest
+func CartesianProduct[T any](slices ...[]T) [][]T {
+	product := [][]T{}
+	if len(slices) == 0 {
+		return product
+	}
+	row := make([]T, len(slices))
+	var walk func(depth int)
+	walk = func(depth int) {
+		if depth == len(slices) {
+			product = append(product, append([]T{}, row...))
+			return
+		}
+		for _, item := range slices[depth] {
+			row[depth] = item
+			walk(depth + 1)
+		}
+	}
+	walk(0)
+	return product
+}
+
+// Interleave returns a new slice taking one item of every slice in turn, skipping the slices which ran out of items
+func Interleave[T any](slices ...[]T) []T {
+	interleaved := []T{}
+	for i := 0; ; i++ {
+		taken := false
+		for _, items := range slices {
+			if i < len(items) {
+				interleaved = append(interleaved, items[i])
+				taken = true
+			}
+		}
+		if !taken {
+			return interleaved
+		}
+	}
+}
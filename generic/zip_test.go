@@ -0,0 +1,74 @@
+package generic
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/rbrahul/gofp/seq"
+)
+
+func Test_Zip(t *testing.T) {
+	zipped := Zip([]int{1, 2, 3}, []string{"a", "b"})
+	want := []seq.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}}
+	if !reflect.DeepEqual(zipped, want) {
+		t.Errorf("Zip() = %v, want %v", zipped, want)
+	}
+}
+
+func Test_ZipLongest(t *testing.T) {
+	zipped := ZipLongest([]int{1}, []string{"a", "b"}, -1, "")
+	want := []seq.Pair[int, string]{{First: 1, Second: "a"}, {First: -1, Second: "b"}}
+	if !reflect.DeepEqual(zipped, want) {
+		t.Errorf("ZipLongest() = %v, want %v", zipped, want)
+	}
+}
+
+func Test_ZipWith(t *testing.T) {
+	labels := ZipWith([]string{"a", "b", "c"}, []int{1, 2}, func(name string, count int) string {
+		return name + strconv.Itoa(count)
+	})
+	if want := []string{"a1", "b2"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("ZipWith() = %v, want %v", labels, want)
+	}
+}
+
+func Test_Unzip(t *testing.T) {
+	numbers, letters := Unzip(Zip([]int{1, 2}, []string{"a", "b"}))
+	if !reflect.DeepEqual(numbers, []int{1, 2}) || !reflect.DeepEqual(letters, []string{"a", "b"}) {
+		t.Errorf("Unzip() = %v, %v, want %v, %v", numbers, letters, []int{1, 2}, []string{"a", "b"})
+	}
+}
+
+func Test_ZipN(t *testing.T) {
+	zipped := ZipN([]int{1, 2, 3}, []int{4, 5}, []int{6, 7, 8})
+	if want := [][]int{{1, 4, 6}, {2, 5, 7}}; !reflect.DeepEqual(zipped, want) {
+		t.Errorf("ZipN() = %v, want %v", zipped, want)
+	}
+	zipped = ZipNLongest(0, []int{1, 2, 3}, []int{4})
+	if want := [][]int{{1, 4}, {2, 0}, {3, 0}}; !reflect.DeepEqual(zipped, want) {
+		t.Errorf("ZipNLongest() = %v, want %v", zipped, want)
+	}
+}
+
+func Test_Transpose(t *testing.T) {
+	transposed := Transpose(Chunk([]int{1, 2, 3, 4, 5}, 2))
+	if want := [][]int{{1, 3, 5}, {2, 4}}; !reflect.DeepEqual(transposed, want) {
+		t.Errorf("Transpose() = %v, want %v", transposed, want)
+	}
+}
+
+func Test_CartesianProduct(t *testing.T) {
+	product := CartesianProduct([]string{"S", "M"}, []string{"red", "blue"})
+	want := [][]string{{"S", "red"}, {"S", "blue"}, {"M", "red"}, {"M", "blue"}}
+	if !reflect.DeepEqual(product, want) {
+		t.Errorf("CartesianProduct() = %v, want %v", product, want)
+	}
+}
+
+func Test_Interleave(t *testing.T) {
+	interleaved := Interleave([]int{1, 2, 3}, []int{10}, []int{20, 30})
+	if want := []int{1, 10, 20, 2, 30, 3}; !reflect.DeepEqual(interleaved, want) {
+		t.Errorf("Interleave() = %v, want %v", interleaved, want)
+	}
+}
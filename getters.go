@@ -0,0 +1,148 @@
+package gofp
+
+import "reflect"
+
+// getAs compiles the path and converts the value at it with convert, the error is a *PathError
+func getAs[T any](data interface{}, path string, convert func(value interface{}) (T, error)) (T, error) {
+	q, err := CompileQuery(path)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return queryAs(q, data, convert)
+}
+
+// toSlice returns the items of any slice or array as a slice of interface
+func toSlice(value interface{}) ([]interface{}, error) {
+	if items, ok := value.([]interface{}); ok {
+		return items, nil
+	}
+	slice := reflect.ValueOf(value)
+	if slice.Kind() != reflect.Slice && slice.Kind() != reflect.Array {
+		return nil, &ConversionError{Value: value, Target: "[]interface {}"}
+	}
+	return interfaceValues(slice), nil
+}
+
+// toMap returns a map with string keys of any type as map[string]interface{}
+func toMap(value interface{}) (map[string]interface{}, error) {
+	mapData, ok := asStringMap(value)
+	if !ok {
+		return nil, &ConversionError{Value: value, Target: "map[string]interface {}"}
+	}
+	return mapData, nil
+}
+
+// GetStringE returns the value at the path converted with CoerceString, the error is a *PathError naming the failing segment
+func GetStringE(data interface{}, path string) (string, error) {
+	return getAs(data, path, CoerceString)
+}
+
+// GetString returns the value at the path converted with CoerceString, ok is false if it's missing or can't be converted
+func GetString(data interface{}, path string) (string, bool) {
+	value, err := GetStringE(data, path)
+	return value, err == nil
+}
+
+// GetStringOr returns the value at the path converted with CoerceString, or fallback if it's missing or can't be converted
+func GetStringOr(data interface{}, path string, fallback string) string {
+	if value, ok := GetString(data, path); ok {
+		return value
+	}
+	return fallback
+}
+
+// GetIntE returns the value at the path converted with CoerceInt, the error is a *PathError naming the failing segment
+func GetIntE(data interface{}, path string) (int, error) {
+	return getAs(data, path, CoerceInt)
+}
+
+// GetInt returns the value at the path converted with CoerceInt, ok is false if it's missing or can't be converted
+func GetInt(data interface{}, path string) (int, bool) {
+	value, err := GetIntE(data, path)
+	return value, err == nil
+}
+
+// GetIntOr returns the value at the path converted with CoerceInt, or fallback if it's missing or can't be converted
+func GetIntOr(data interface{}, path string, fallback int) int {
+	if value, ok := GetInt(data, path); ok {
+		return value
+	}
+	return fallback
+}
+
+// GetFloat64E returns the value at the path converted with CoerceFloat64, the error is a *PathError naming the failing segment
+func GetFloat64E(data interface{}, path string) (float64, error) {
+	return getAs(data, path, CoerceFloat64)
+}
+
+// GetFloat64 returns the value at the path converted with CoerceFloat64, ok is false if it's missing or can't be converted
+func GetFloat64(data interface{}, path string) (float64, bool) {
+	value, err := GetFloat64E(data, path)
+	return value, err == nil
+}
+
+// GetFloat64Or returns the value at the path converted with CoerceFloat64, or fallback if it's missing or can't be converted
+func GetFloat64Or(data interface{}, path string, fallback float64) float64 {
+	if value, ok := GetFloat64(data, path); ok {
+		return value
+	}
+	return fallback
+}
+
+// GetBoolE returns the value at the path converted with CoerceBool, the error is a *PathError naming the failing segment
+func GetBoolE(data interface{}, path string) (bool, error) {
+	return getAs(data, path, CoerceBool)
+}
+
+// GetBool returns the value at the path converted with CoerceBool, ok is false if it's missing or can't be converted
+func GetBool(data interface{}, path string) (bool, bool) {
+	value, err := GetBoolE(data, path)
+	return value, err == nil
+}
+
+// GetBoolOr returns the value at the path converted with CoerceBool, or fallback if it's missing or can't be converted
+func GetBoolOr(data interface{}, path string, fallback bool) bool {
+	if value, ok := GetBool(data, path); ok {
+		return value
+	}
+	return fallback
+}
+
+// GetSliceE returns the slice or array at the path as a slice of interface, the error is a *PathError naming the failing segment
+func GetSliceE(data interface{}, path string) ([]interface{}, error) {
+	return getAs(data, path, toSlice)
+}
+
+// GetSlice returns the slice or array at the path as a slice of interface, ok is false if it's missing or not a slice
+func GetSlice(data interface{}, path string) ([]interface{}, bool) {
+	value, err := GetSliceE(data, path)
+	return value, err == nil
+}
+
+// GetSliceOr returns the slice or array at the path as a slice of interface, or fallback if it's missing or not a slice
+func GetSliceOr(data interface{}, path string, fallback []interface{}) []interface{} {
+	if value, ok := GetSlice(data, path); ok {
+		return value
+	}
+	return fallback
+}
+
+// GetMapE returns the map with string keys at the path as map[string]interface{}, the error is a *PathError naming the failing segment
+func GetMapE(data interface{}, path string) (map[string]interface{}, error) {
+	return getAs(data, path, toMap)
+}
+
+// GetMap returns the map with string keys at the path as map[string]interface{}, ok is false if it's missing or not a map
+func GetMap(data interface{}, path string) (map[string]interface{}, bool) {
+	value, err := GetMapE(data, path)
+	return value, err == nil
+}
+
+// GetMapOr returns the map with string keys at the path as map[string]interface{}, or fallback if it's missing or not a map
+func GetMapOr(data interface{}, path string, fallback map[string]interface{}) map[string]interface{} {
+	if value, ok := GetMap(data, path); ok {
+		return value
+	}
+	return fallback
+}
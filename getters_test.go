@@ -0,0 +1,108 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var payload = map[string]interface{}{
+	"user": map[string]interface{}{
+		"name":   "Sofia",
+		"age":    float64(20),
+		"score":  json.Number("9.5"),
+		"active": true,
+		"tags":   []string{"admin", "dev"},
+		"labels": map[string]string{"team": "core"},
+	},
+	"items": []interface{}{
+		map[string]interface{}{"id": float64(1)},
+	},
+}
+
+func Test_GetString(t *testing.T) {
+	if name, ok := GetString(payload, "user.name"); !ok || name != "Sofia" {
+		t.Errorf("GetString() = %v, %v, want %v, %v", name, ok, "Sofia", true)
+	}
+	if name := GetStringOr(payload, "user.nickname", "anonymous"); name != "anonymous" {
+		t.Errorf("GetStringOr() = %v, want %v", name, "anonymous")
+	}
+}
+
+func Test_GetInt(t *testing.T) {
+	if age, ok := GetInt(payload, "user.age"); !ok || age != 20 {
+		t.Errorf("GetInt() = %v, %v, want %v, %v", age, ok, 20, true)
+	}
+	if id := GetIntOr(payload, "items[-1].id", 0); id != 1 {
+		t.Errorf("GetIntOr() = %v, want %v", id, 1)
+	}
+	if score, ok := GetInt(payload, "user.score"); ok {
+		t.Errorf("GetInt() = %v, %v, want %v, %v", score, ok, 0, false)
+	}
+}
+
+func Test_GetFloat64AndBool(t *testing.T) {
+	if score := GetFloat64Or(payload, "user.score", 0); score != 9.5 {
+		t.Errorf("GetFloat64Or() = %v, want %v", score, 9.5)
+	}
+	if active, ok := GetBool(payload, "user.active"); !ok || !active {
+		t.Errorf("GetBool() = %v, %v, want %v, %v", active, ok, true, true)
+	}
+	if active := GetBoolOr(payload, "user.name", false); active {
+		t.Errorf("GetBoolOr() = %v, want %v", active, false)
+	}
+}
+
+func Test_GetSliceAndMap(t *testing.T) {
+	if tags, ok := GetSlice(payload, "user.tags"); !ok || !reflect.DeepEqual(tags, []interface{}{"admin", "dev"}) {
+		t.Errorf("GetSlice() = %v, %v, want %v, %v", tags, ok, []interface{}{"admin", "dev"}, true)
+	}
+	if labels, ok := GetMap(payload, "user.labels"); !ok || labels["team"] != "core" {
+		t.Errorf("GetMap() = %v, %v, want %v, %v", labels, ok, map[string]interface{}{"team": "core"}, true)
+	}
+	if items := GetSliceOr(payload, "user", nil); items != nil {
+		t.Errorf("GetSliceOr() = %v, want %v", items, nil)
+	}
+	if user := GetMapOr(payload, "items", nil); user != nil {
+		t.Errorf("GetMapOr() = %v, want %v", user, nil)
+	}
+}
+
+func Test_GetStrictErrors(t *testing.T) {
+	tests := []struct {
+		path    string
+		index   int
+		segment string
+		err     error
+	}{
+		{"user.email", 1, "email", ErrKeyNotFound},
+		{"items[3].id", 1, "3", ErrIndexOutOfRange},
+		{"items.first", 1, "first", ErrInvalidIndex},
+		{"user.*", 1, "*", ErrWildcard},
+	}
+	for _, tt := range tests {
+		_, err := GetIntE(payload, tt.path)
+		var pathErr *PathError
+		if !errors.As(err, &pathErr) || pathErr.Index != tt.index || pathErr.Segment != tt.segment || !errors.Is(err, tt.err) {
+			t.Errorf("GetIntE(%q) error = %v, want %v at segment %d %q", tt.path, err, tt.err, tt.index, tt.segment)
+		}
+	}
+	_, err := GetStringE(payload, "user.age.value")
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Actual != "float64" {
+		t.Errorf("GetStringE() error = %v, want a type mismatch on float64", err)
+	}
+	if want := `path "user.age.value": segment 2 "value": expected map, slice, struct or string, got float64`; err.Error() != want {
+		t.Errorf("GetStringE() error = %q, want %q", err.Error(), want)
+	}
+	_, err = GetBoolE(payload, "user.name")
+	var conversion *ConversionError
+	if !errors.As(err, &conversion) || conversion.Target != "bool" {
+		t.Errorf("GetBoolE() error = %v, want a ConversionError to bool", err)
+	}
+	var pathErr *PathError
+	if _, err = GetMapE(payload, "user..name"); !errors.As(err, &pathErr) || pathErr.Index != -1 {
+		t.Errorf("GetMapE() error = %v, want an invalid path error", err)
+	}
+}
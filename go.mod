@@ -1,3 +1,3 @@
 module github.com/rbrahul/gofp
 
-go 1.12
+go 1.23
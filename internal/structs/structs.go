@@ -0,0 +1,63 @@
+// Package structs lists the fields of structs the same way for gofp and jsonpath.
+package structs
+
+import "reflect"
+
+// Member is an exported field of a struct, either declared by it or promoted from an embedded struct
+type Member struct {
+	Name  string
+	Value reflect.Value
+}
+
+// Members returns the exported fields of a struct in declaration order, including the fields promoted
+// from embedded structs. Like in Go, a shallower field hides deeper ones with the same name and fields with
+// the same name at the same depth hide each other. Embedded nil pointers are skipped together with their fields
+func Members(value reflect.Value) []Member {
+	type field struct {
+		Member
+		depth int
+	}
+	fields := []field{}
+	ancestors := map[reflect.Type]bool{}
+	var collect func(value reflect.Value, depth int)
+	collect = func(value reflect.Value, depth int) {
+		ancestors[value.Type()] = true
+		defer delete(ancestors, value.Type())
+		for i := 0; i < value.NumField(); i++ {
+			structField := value.Type().Field(i)
+			embedded := value.Field(i)
+			if structField.Anonymous && embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if structField.IsExported() {
+				fields = append(fields, field{Member: Member{Name: structField.Name, Value: value.Field(i)}, depth: depth})
+			}
+			if structField.Anonymous && embedded.Kind() == reflect.Struct && !ancestors[embedded.Type()] {
+				collect(embedded, depth+1)
+			}
+		}
+	}
+	collect(value, 0)
+	shallowest := map[string]int{}
+	for _, f := range fields {
+		if depth, exists := shallowest[f.Name]; !exists || f.depth < depth {
+			shallowest[f.Name] = f.depth
+		}
+	}
+	counts := map[string]int{}
+	for _, f := range fields {
+		if f.depth == shallowest[f.Name] {
+			counts[f.Name]++
+		}
+	}
+	members := []Member{}
+	for _, f := range fields {
+		if f.depth == shallowest[f.Name] && counts[f.Name] == 1 {
+			members = append(members, f.Member)
+		}
+	}
+	return members
+}
@@ -0,0 +1,318 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+// logicalExpr is a filter expression producing true or false
+type logicalExpr interface {
+	test(current Node, root interface{}) bool
+}
+
+// operand is a side of a comparison, present is false when it produces Nothing
+type operand interface {
+	value(current Node, root interface{}) (value interface{}, present bool)
+}
+
+type orExpr []logicalExpr
+
+func (e orExpr) test(current Node, root interface{}) bool {
+	for _, expr := range e {
+		if expr.test(current, root) {
+			return true
+		}
+	}
+	return false
+}
+
+type andExpr []logicalExpr
+
+func (e andExpr) test(current Node, root interface{}) bool {
+	for _, expr := range e {
+		if !expr.test(current, root) {
+			return false
+		}
+	}
+	return true
+}
+
+type notExpr struct {
+	expr logicalExpr
+}
+
+func (e notExpr) test(current Node, root interface{}) bool {
+	return !e.expr.test(current, root)
+}
+
+// existsExpr is true if the query selects at least one node
+type existsExpr struct {
+	query *filterQuery
+}
+
+func (e existsExpr) test(current Node, root interface{}) bool {
+	return len(e.query.nodes(current, root)) > 0
+}
+
+type comparisonExpr struct {
+	left, right operand
+	op          string
+}
+
+func (e comparisonExpr) test(current Node, root interface{}) bool {
+	left, leftPresent := e.left.value(current, root)
+	right, rightPresent := e.right.value(current, root)
+	switch e.op {
+	case "==":
+		return equal(left, leftPresent, right, rightPresent)
+	case "!=":
+		return !equal(left, leftPresent, right, rightPresent)
+	case "<":
+		return less(left, leftPresent, right, rightPresent)
+	case "<=":
+		return less(left, leftPresent, right, rightPresent) || equal(left, leftPresent, right, rightPresent)
+	case ">":
+		return less(right, rightPresent, left, leftPresent)
+	case ">=":
+		return less(right, rightPresent, left, leftPresent) || equal(left, leftPresent, right, rightPresent)
+	}
+	return false
+}
+
+type literal struct {
+	v interface{}
+}
+
+func (l literal) value(current Node, root interface{}) (interface{}, bool) {
+	return l.v, true
+}
+
+// filterQuery is a query inside a filter, relative to the current node (@) or the root ($)
+type filterQuery struct {
+	relative bool
+	segments []segment
+}
+
+func (q *filterQuery) nodes(current Node, root interface{}) []Node {
+	start := Node{Path: "$", Value: root}
+	if q.relative {
+		start = Node{Path: "@", Value: current.Value}
+	}
+	return evalSegments(q.segments, []Node{start}, root)
+}
+
+// singular reports whether the query selects at most one node
+func (q *filterQuery) singular() bool {
+	for _, seg := range q.segments {
+		if seg.descendant || len(seg.selectors) != 1 {
+			return false
+		}
+		switch seg.selectors[0].(type) {
+		case nameSelector, indexSelector:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (q *filterQuery) value(current Node, root interface{}) (interface{}, bool) {
+	nodes := q.nodes(current, root)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0].Value, true
+}
+
+type resultType int
+
+const (
+	valueResult resultType = iota
+	logicalResult
+)
+
+type paramType int
+
+const (
+	valueParam paramType = iota
+	nodesParam
+)
+
+type function struct {
+	params []paramType
+	result resultType
+	call   func(args []argument) (interface{}, bool)
+}
+
+// argument is an evaluated function argument, either a value (possibly Nothing) or a list of nodes
+type argument struct {
+	value   interface{}
+	present bool
+	nodes   []Node
+}
+
+var functions = map[string]function{
+	"length": {params: []paramType{valueParam}, result: valueResult, call: lengthFunction},
+	"count":  {params: []paramType{nodesParam}, result: valueResult, call: countFunction},
+	"match":  {params: []paramType{valueParam, valueParam}, result: logicalResult, call: regexpFunction(true)},
+	"search": {params: []paramType{valueParam, valueParam}, result: logicalResult, call: regexpFunction(false)},
+	"value":  {params: []paramType{nodesParam}, result: valueResult, call: valueFunction},
+}
+
+func lengthFunction(args []argument) (interface{}, bool) {
+	if !args[0].present {
+		return nil, false
+	}
+	if s, ok := stringValue(args[0].value); ok {
+		return utf8.RuneCountInString(s), true
+	}
+	if members, ok := objectMembers(args[0].value); ok {
+		return len(members), true
+	}
+	if array, ok := arrayValue(args[0].value); ok {
+		return array.Len(), true
+	}
+	return nil, false
+}
+
+func countFunction(args []argument) (interface{}, bool) {
+	return len(args[0].nodes), true
+}
+
+func valueFunction(args []argument) (interface{}, bool) {
+	if len(args[0].nodes) != 1 {
+		return nil, false
+	}
+	return args[0].nodes[0].Value, true
+}
+
+func regexpFunction(full bool) func(args []argument) (interface{}, bool) {
+	return func(args []argument) (interface{}, bool) {
+		s, ok := stringValue(args[0].value)
+		pattern, isString := stringValue(args[1].value)
+		if !args[0].present || !args[1].present || !ok || !isString {
+			return false, true
+		}
+		if full {
+			pattern = "^(?:" + pattern + ")$"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, true
+		}
+		return re.MatchString(s), true
+	}
+}
+
+type functionCall struct {
+	name string
+	fn   function
+	args []interface{}
+}
+
+func (f *functionCall) evaluate(current Node, root interface{}) (interface{}, bool) {
+	args := make([]argument, len(f.args))
+	for i, arg := range f.args {
+		if f.fn.params[i] == nodesParam {
+			args[i] = argument{nodes: arg.(*filterQuery).nodes(current, root)}
+			continue
+		}
+		value, present := arg.(operand).value(current, root)
+		args[i] = argument{value: value, present: present}
+	}
+	return f.fn.call(args)
+}
+
+func (f *functionCall) value(current Node, root interface{}) (interface{}, bool) {
+	return f.evaluate(current, root)
+}
+
+func (f *functionCall) test(current Node, root interface{}) bool {
+	result, _ := f.evaluate(current, root)
+	matched, _ := result.(bool)
+	return matched
+}
+
+func stringValue(data interface{}) (string, bool) {
+	if _, ok := data.(json.Number); ok {
+		return "", false
+	}
+	value := indirect(data)
+	if value.Kind() == reflect.String {
+		return value.String(), true
+	}
+	return "", false
+}
+
+func boolValue(data interface{}) (bool, bool) {
+	value := indirect(data)
+	if value.Kind() == reflect.Bool {
+		return value.Bool(), true
+	}
+	return false, false
+}
+
+// equal compares two values following the JSON data model, Nothing only equals Nothing
+func equal(a interface{}, aPresent bool, b interface{}, bPresent bool) bool {
+	if !aPresent || !bPresent {
+		return !aPresent && !bPresent
+	}
+	if isNull(a) || isNull(b) {
+		return isNull(a) && isNull(b)
+	}
+	if x, ok := number(a); ok {
+		y, isNumber := number(b)
+		return isNumber && x == y
+	}
+	if x, ok := stringValue(a); ok {
+		y, isString := stringValue(b)
+		return isString && x == y
+	}
+	if x, ok := boolValue(a); ok {
+		y, isBool := boolValue(b)
+		return isBool && x == y
+	}
+	if x, ok := arrayValue(a); ok {
+		y, isArray := arrayValue(b)
+		if !isArray || x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !equal(x.Index(i).Interface(), true, y.Index(i).Interface(), true) {
+				return false
+			}
+		}
+		return true
+	}
+	if x, ok := objectMembers(a); ok {
+		y, isObject := objectMembers(b)
+		if !isObject || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i].name != y[i].name || !equal(x[i].value, true, y[i].value, true) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// less orders numbers and strings, any other combination is false
+func less(a interface{}, aPresent bool, b interface{}, bPresent bool) bool {
+	if !aPresent || !bPresent {
+		return false
+	}
+	if x, ok := number(a); ok {
+		y, isNumber := number(b)
+		return isNumber && x < y
+	}
+	if x, ok := stringValue(a); ok {
+		y, isString := stringValue(b)
+		return isString && x < y
+	}
+	return false
+}
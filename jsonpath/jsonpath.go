@@ -0,0 +1,368 @@
+// Package jsonpath implements JSONPath queries as defined by RFC 9535. Queries are evaluated
+// against the same data shapes gofp.Get handles: maps with string keys and structs are objects,
+// slices and arrays are arrays, and pointers and interfaces are followed. Struct fields are
+// addressed by their Go name, just like with gofp.Get.
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rbrahul/gofp/internal/structs"
+)
+
+// Node is a value selected by a query together with its normalized path, e.g. $['store']['book'][0]
+type Node struct {
+	Path  string
+	Value interface{}
+}
+
+// Path is a compiled JSONPath query which can be evaluated any number of times
+type Path struct {
+	expr     string
+	segments []segment
+}
+
+// Compile parses a JSONPath query
+func Compile(expr string) (*Path, error) {
+	p := &parser{src: expr}
+	segments, err := p.parseQuery()
+	if err != nil {
+		return nil, fmt.Errorf("jsonpath: invalid query %q: %w", expr, err)
+	}
+	return &Path{expr: expr, segments: segments}, nil
+}
+
+// MustCompile works like Compile but panics if the query is invalid
+func MustCompile(expr string) *Path {
+	path, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return path
+}
+
+// Query compiles the query and evaluates it against data
+func Query(expr string, data interface{}) ([]Node, error) {
+	path, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	return path.Select(data), nil
+}
+
+// String returns the query the Path was compiled from
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Select returns every node matched by the query in the order defined by RFC 9535. Members of
+// maps are visited in key order and fields of structs in declaration order
+func (p *Path) Select(data interface{}) []Node {
+	return evalSegments(p.segments, []Node{{Path: "$", Value: data}}, data)
+}
+
+// Values returns the values of every node matched by the query
+func (p *Path) Values(data interface{}) []interface{} {
+	nodes := p.Select(data)
+	values := make([]interface{}, len(nodes))
+	for i, node := range nodes {
+		values[i] = node.Value
+	}
+	return values
+}
+
+type segment struct {
+	descendant bool
+	selectors  []selector
+}
+
+type selector interface {
+	apply(node Node, root interface{}, emit func(Node))
+}
+
+func evalSegments(segments []segment, nodes []Node, root interface{}) []Node {
+	for _, seg := range segments {
+		next := []Node{}
+		emit := func(node Node) {
+			next = append(next, node)
+		}
+		for _, node := range nodes {
+			if seg.descendant {
+				descend(node, func(descendant Node) {
+					for _, sel := range seg.selectors {
+						sel.apply(descendant, root, emit)
+					}
+				})
+				continue
+			}
+			for _, sel := range seg.selectors {
+				sel.apply(node, root, emit)
+			}
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// descend visits the node and all of its descendants in document order
+func descend(node Node, visit func(Node)) {
+	visit(node)
+	forEachChild(node, func(child Node) {
+		descend(child, visit)
+	})
+}
+
+func forEachChild(node Node, fn func(Node)) {
+	if members, ok := objectMembers(node.Value); ok {
+		for _, m := range members {
+			fn(Node{Path: node.Path + formatName(m.name), Value: m.value})
+		}
+		return
+	}
+	if array, ok := arrayValue(node.Value); ok {
+		for i := 0; i < array.Len(); i++ {
+			fn(Node{Path: node.Path + formatIndex(i), Value: array.Index(i).Interface()})
+		}
+	}
+}
+
+type nameSelector struct {
+	name string
+}
+
+func (s nameSelector) apply(node Node, root interface{}, emit func(Node)) {
+	if value, ok := objectMember(node.Value, s.name); ok {
+		emit(Node{Path: node.Path + formatName(s.name), Value: value})
+	}
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) apply(node Node, root interface{}, emit func(Node)) {
+	forEachChild(node, emit)
+}
+
+type indexSelector struct {
+	index int
+}
+
+func (s indexSelector) apply(node Node, root interface{}, emit func(Node)) {
+	array, ok := arrayValue(node.Value)
+	if !ok {
+		return
+	}
+	index := s.index
+	if index < 0 {
+		index += array.Len()
+	}
+	if index >= 0 && index < array.Len() {
+		emit(Node{Path: node.Path + formatIndex(index), Value: array.Index(index).Interface()})
+	}
+}
+
+type sliceSelector struct {
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) apply(node Node, root interface{}, emit func(Node)) {
+	array, ok := arrayValue(node.Value)
+	if !ok || s.step == 0 {
+		return
+	}
+	length := array.Len()
+	normalize := func(i int) int {
+		if i >= 0 {
+			return i
+		}
+		return length + i
+	}
+	clamp := func(i, min, max int) int {
+		if i < min {
+			return min
+		}
+		if i > max {
+			return max
+		}
+		return i
+	}
+	emitIndex := func(i int) {
+		emit(Node{Path: node.Path + formatIndex(i), Value: array.Index(i).Interface()})
+	}
+	if s.step > 0 {
+		start, end := 0, length
+		if s.start != nil {
+			start = normalize(*s.start)
+		}
+		if s.end != nil {
+			end = normalize(*s.end)
+		}
+		lower, upper := clamp(start, 0, length), clamp(end, 0, length)
+		for i := lower; i < upper; i += s.step {
+			emitIndex(i)
+		}
+		return
+	}
+	start, end := length-1, -length-1
+	if s.start != nil {
+		start = normalize(*s.start)
+	}
+	if s.end != nil {
+		end = normalize(*s.end)
+	}
+	upper, lower := clamp(start, -1, length-1), clamp(end, -1, length-1)
+	for i := upper; lower < i; i += s.step {
+		emitIndex(i)
+	}
+}
+
+type filterSelector struct {
+	expr logicalExpr
+}
+
+func (s filterSelector) apply(node Node, root interface{}, emit func(Node)) {
+	forEachChild(node, func(child Node) {
+		if s.expr.test(child, root) {
+			emit(child)
+		}
+	})
+}
+
+// formatName returns the normalized path segment of an object member
+func formatName(name string) string {
+	var b strings.Builder
+	b.WriteString("['")
+	for _, r := range name {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '\'':
+			b.WriteString(`\'`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(&b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+	b.WriteString("']")
+	return b.String()
+}
+
+func formatIndex(index int) string {
+	return "[" + strconv.Itoa(index) + "]"
+}
+
+type member struct {
+	name  string
+	value interface{}
+}
+
+func indirect(data interface{}) reflect.Value {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// objectMembers returns the members of a map with string keys in key order or the exported fields of a struct
+func objectMembers(data interface{}) ([]member, bool) {
+	value := indirect(data)
+	switch {
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		members := make([]member, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			members = append(members, member{name: iter.Key().String(), value: iter.Value().Interface()})
+		}
+		sort.Slice(members, func(i, j int) bool {
+			return members[i].name < members[j].name
+		})
+		return members, true
+	case value.Kind() == reflect.Struct:
+		return structMembers(value), true
+	}
+	return nil, false
+}
+
+func objectMember(data interface{}, name string) (interface{}, bool) {
+	if mapData, ok := data.(map[string]interface{}); ok {
+		value, exists := mapData[name]
+		return value, exists
+	}
+	value := indirect(data)
+	switch {
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		item := value.MapIndex(reflect.ValueOf(name).Convert(value.Type().Key()))
+		if !item.IsValid() {
+			return nil, false
+		}
+		return item.Interface(), true
+	case value.Kind() == reflect.Struct:
+		for _, field := range structMembers(value) {
+			if field.name == name {
+				return field.value, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// structMembers returns the exported fields of a struct including the promoted ones, see structs.Members
+func structMembers(value reflect.Value) []member {
+	members := []member{}
+	for _, field := range structs.Members(value) {
+		members = append(members, member{name: field.Name, value: field.Value.Interface()})
+	}
+	return members
+}
+
+func arrayValue(data interface{}) (reflect.Value, bool) {
+	value := indirect(data)
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		return value, true
+	}
+	return value, false
+}
+
+// number returns the value of any Go numeric type or json.Number as float64
+func number(data interface{}) (float64, bool) {
+	if n, ok := data.(json.Number); ok {
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	value := indirect(data)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
+func isNull(data interface{}) bool {
+	return !indirect(data).IsValid()
+}
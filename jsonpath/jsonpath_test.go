@@ -0,0 +1,177 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+const storeJSON = `{
+	"store": {
+		"book": [
+			{"category": "reference", "author": "Nigel Rees", "title": "Sayings of the Century", "price": 8.95},
+			{"category": "fiction", "author": "Evelyn Waugh", "title": "Sword of Honour", "price": 12.99},
+			{"category": "fiction", "author": "Herman Melville", "title": "Moby Dick", "isbn": "0-553-21311-3", "price": 8.99},
+			{"category": "fiction", "author": "J. R. R. Tolkien", "title": "The Lord of the Rings", "isbn": "0-395-19395-8", "price": 22.99}
+		],
+		"bicycle": {"color": "red", "price": 399}
+	}
+}`
+
+func store(t *testing.T) map[string]interface{} {
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(storeJSON), &data); err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func paths(nodes []Node) []string {
+	result := []string{}
+	for _, node := range nodes {
+		result = append(result, node.Path)
+	}
+	return result
+}
+
+func Test_Query(t *testing.T) {
+	data := store(t)
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"$.store.book[*].author", []string{
+			"$['store']['book'][0]['author']", "$['store']['book'][1]['author']",
+			"$['store']['book'][2]['author']", "$['store']['book'][3]['author']",
+		}},
+		{"$..author", []string{
+			"$['store']['book'][0]['author']", "$['store']['book'][1]['author']",
+			"$['store']['book'][2]['author']", "$['store']['book'][3]['author']",
+		}},
+		{"$.store.*", []string{"$['store']['bicycle']", "$['store']['book']"}},
+		{"$.store..price", []string{
+			"$['store']['bicycle']['price']", "$['store']['book'][0]['price']", "$['store']['book'][1]['price']",
+			"$['store']['book'][2]['price']", "$['store']['book'][3]['price']",
+		}},
+		{"$..book[2]", []string{"$['store']['book'][2]"}},
+		{"$..book[-1]", []string{"$['store']['book'][3]"}},
+		{"$..book[0,1]", []string{"$['store']['book'][0]", "$['store']['book'][1]"}},
+		{"$..book[:2]", []string{"$['store']['book'][0]", "$['store']['book'][1]"}},
+		{"$..book[::-2]", []string{"$['store']['book'][3]", "$['store']['book'][1]"}},
+		{"$..book[?@.isbn]", []string{"$['store']['book'][2]", "$['store']['book'][3]"}},
+		{"$..book[?@.price<10]", []string{"$['store']['book'][0]", "$['store']['book'][2]"}},
+		{"$..book[?@.price > 10 && @.category == 'fiction'].title", []string{
+			"$['store']['book'][1]['title']", "$['store']['book'][3]['title']",
+		}},
+		{"$..book[?!(@.price > 10) || @.price == $.store.bicycle.price]", []string{
+			"$['store']['book'][0]", "$['store']['book'][2]",
+		}},
+		{`$["store"]['bicycle'].color`, []string{"$['store']['bicycle']['color']"}},
+		{"$.store.book[?length(@.title) > 15 && search(@.author, 'Tolkien')]", []string{"$['store']['book'][3]"}},
+		{"$.store[?count(@.*) == 2]", []string{"$['store']['bicycle']"}},
+		{"$.store.book[?match(@.isbn, '0-395-.*')]", []string{"$['store']['book'][3]"}},
+		{"$.store.book[?value(@..isbn) == '0-553-21311-3'].title", []string{"$['store']['book'][2]['title']"}},
+		{"$.missing[0]", []string{}},
+	}
+	for _, tt := range tests {
+		nodes, err := Query(tt.query, data)
+		if err != nil {
+			t.Errorf("Query(%q) error = %v", tt.query, err)
+			continue
+		}
+		if got := paths(nodes); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Query(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func Test_Compile(t *testing.T) {
+	invalid := []string{
+		"", "store", "$.", "$[", "$[01]", "$[-0]", "$['a'", "$[?@.a == ]", "$[?@..a == 1]",
+		"$[?@.* == 1]", "$[?length(@.a)]", "$[?count(1) == 1]", "$[?unknown(@)]", "$.a]",
+		"$[9007199254740992]",
+	}
+	for _, query := range invalid {
+		if _, err := Compile(query); err == nil {
+			t.Errorf("Compile(%q) error = %v, want an error", query, err)
+		}
+	}
+}
+
+func Test_MustCompile(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("MustCompile() didn't panic")
+		}
+	}()
+	MustCompile("$[")
+}
+
+func Test_Values(t *testing.T) {
+	got := MustCompile("$..book[?@.price > 20].title").Values(store(t))
+	want := []interface{}{"The Lord of the Rings"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func Test_SelectStructs(t *testing.T) {
+	type item struct {
+		Name  string
+		Price int
+		tag   string
+	}
+	type order struct {
+		ID    string
+		Items []*item
+	}
+	data := map[string]interface{}{
+		"orders": []order{
+			{ID: "a", Items: []*item{{Name: "pen", Price: 2}, {Name: "book", Price: 15, tag: "x"}}},
+			{ID: "b", Items: []*item{{Name: "lamp", Price: 40}}},
+		},
+	}
+	got := MustCompile("$.orders[*].Items[?@.Price > 10].Name").Values(data)
+	want := []interface{}{"book", "lamp"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Select() = %v, want %v", got, want)
+	}
+	if fields := MustCompile("$.orders[0].Items[0].*").Values(data); len(fields) != 2 {
+		t.Errorf("Select() = %v, want %v fields", fields, 2)
+	}
+}
+
+func Test_SelectEmbeddedStructs(t *testing.T) {
+	type inner struct {
+		X int
+	}
+	type outer struct {
+		*inner
+		Y int
+	}
+	if got := MustCompile("$.X").Values(outer{Y: 1}); len(got) != 0 {
+		t.Errorf("Select() = %v, want %v", got, []interface{}{})
+	}
+	data := outer{inner: &inner{X: 2}, Y: 1}
+	tests := []struct {
+		query string
+		want  []interface{}
+	}{
+		{"$.X", []interface{}{2}},
+		{"$.*", []interface{}{2, 1}},
+		{"$..X", []interface{}{2}},
+	}
+	for _, tt := range tests {
+		if got := MustCompile(tt.query).Values(data); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Select(%v) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func Test_NormalizedPathEscaping(t *testing.T) {
+	data := map[string]interface{}{"it's\n": 1}
+	nodes := MustCompile(`$['it\'s\n']`).Select(data)
+	if len(nodes) != 1 || nodes[0].Path != `$['it\'s\n']` {
+		t.Errorf("Select() = %v, want path %v", nodes, `$['it\'s\n']`)
+	}
+}
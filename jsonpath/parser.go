@@ -0,0 +1,588 @@
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxInt is the largest integer allowed in indexes and slices, 2^53-1
+const maxInt = 1<<53 - 1
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) consume(prefix string) bool {
+	if strings.HasPrefix(p.src[p.pos:], prefix) {
+		p.pos += len(prefix)
+		return true
+	}
+	return false
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && strings.IndexByte(" \t\n\r", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *parser) parseQuery() ([]segment, error) {
+	if !p.consume("$") {
+		return nil, p.errorf("query must start with $")
+	}
+	segments, err := p.parseSegments()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, p.errorf("unexpected character %q", p.peek())
+	}
+	return segments, nil
+}
+
+// parseSegments parses segments until a character which can't start a segment
+func (p *parser) parseSegments() ([]segment, error) {
+	segments := []segment{}
+	for {
+		start := p.pos
+		p.skipSpace()
+		switch {
+		case p.consume(".."):
+			seg, err := p.parseSegmentBody(true)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case p.consume("."):
+			seg, err := p.parseSegmentBody(false)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, seg)
+		case p.peek() == '[':
+			selectors, err := p.parseBracketed()
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment{selectors: selectors})
+		default:
+			p.pos = start
+			return segments, nil
+		}
+	}
+}
+
+// parseSegmentBody parses what follows . or .., a wildcard, a member name or, after .., brackets
+func (p *parser) parseSegmentBody(descendant bool) (segment, error) {
+	if p.consume("*") {
+		return segment{descendant: descendant, selectors: []selector{wildcardSelector{}}}, nil
+	}
+	if descendant && p.peek() == '[' {
+		selectors, err := p.parseBracketed()
+		return segment{descendant: true, selectors: selectors}, err
+	}
+	name := p.parseName()
+	if name == "" {
+		return segment{}, p.errorf("expected member name")
+	}
+	return segment{descendant: descendant, selectors: []selector{nameSelector{name: name}}}, nil
+}
+
+func isNameFirst(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (p *parser) parseName() string {
+	start := p.pos
+	if p.eof() || !isNameFirst(p.peek()) {
+		return ""
+	}
+	for !p.eof() && (isNameFirst(p.peek()) || isDigit(p.peek())) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *parser) parseBracketed() ([]selector, error) {
+	p.pos++
+	selectors := []selector{}
+	for {
+		p.skipSpace()
+		sel, err := p.parseSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, sel)
+		p.skipSpace()
+		if p.consume("]") {
+			return selectors, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected , or ]")
+		}
+	}
+}
+
+func (p *parser) parseSelector() (selector, error) {
+	switch c := p.peek(); {
+	case c == '\'' || c == '"':
+		name, err := p.parseString()
+		return nameSelector{name: name}, err
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+	case c == '?':
+		p.pos++
+		expr, err := p.parseLogicalOr()
+		return filterSelector{expr: expr}, err
+	}
+	var start *int
+	if p.peek() != ':' {
+		index, err := p.parseInt()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if p.peek() != ':' {
+			return indexSelector{index: index}, nil
+		}
+		start = &index
+	}
+	return p.parseSlice(start)
+}
+
+func (p *parser) parseSlice(start *int) (selector, error) {
+	slice := sliceSelector{start: start, step: 1}
+	p.consume(":")
+	p.skipSpace()
+	if p.peek() == '-' || isDigit(p.peek()) {
+		end, err := p.parseInt()
+		if err != nil {
+			return nil, err
+		}
+		slice.end = &end
+		p.skipSpace()
+	}
+	if p.consume(":") {
+		p.skipSpace()
+		if p.peek() == '-' || isDigit(p.peek()) {
+			step, err := p.parseInt()
+			if err != nil {
+				return nil, err
+			}
+			slice.step = step
+		}
+	}
+	return slice, nil
+}
+
+func (p *parser) parseInt() (int, error) {
+	start := p.pos
+	p.consume("-")
+	digits := p.pos
+	for !p.eof() && isDigit(p.peek()) {
+		p.pos++
+	}
+	text := p.src[start:p.pos]
+	if p.pos == digits || (p.src[digits] == '0' && (p.pos-digits > 1 || digits > start)) {
+		p.pos = start
+		return 0, p.errorf("invalid integer %q", text)
+	}
+	value, err := strconv.Atoi(text)
+	if err != nil || value > maxInt || value < -maxInt {
+		p.pos = start
+		return 0, p.errorf("integer %q out of range", text)
+	}
+	return value, nil
+}
+
+func (p *parser) parseString() (string, error) {
+	quote := p.peek()
+	p.pos++
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return b.String(), nil
+		case c < 0x20:
+			return "", p.errorf("control character in string")
+		case c != '\\':
+			b.WriteByte(c)
+			continue
+		}
+		if p.eof() {
+			return "", p.errorf("unterminated string")
+		}
+		escaped := p.peek()
+		p.pos++
+		switch escaped {
+		case 'b':
+			b.WriteByte('\b')
+		case 'f':
+			b.WriteByte('\f')
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case '/', '\\':
+			b.WriteByte(escaped)
+		case 'u':
+			r, err := p.parseUnicodeEscape()
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(r)
+		default:
+			if escaped != quote {
+				return "", p.errorf("invalid escape \\%c", escaped)
+			}
+			b.WriteByte(escaped)
+		}
+	}
+}
+
+// parseUnicodeEscape parses the hex digits following \u, including a following low surrogate
+func (p *parser) parseUnicodeEscape() (rune, error) {
+	hex := func() (rune, error) {
+		if p.pos+4 > len(p.src) {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		value, err := strconv.ParseUint(p.src[p.pos:p.pos+4], 16, 32)
+		if err != nil {
+			return 0, p.errorf("invalid unicode escape")
+		}
+		p.pos += 4
+		return rune(value), nil
+	}
+	r, err := hex()
+	if err != nil || !utf16.IsSurrogate(r) {
+		return r, err
+	}
+	if !p.consume(`\u`) {
+		return 0, p.errorf("missing low surrogate")
+	}
+	low, err := hex()
+	if err != nil {
+		return 0, err
+	}
+	if decoded := utf16.DecodeRune(r, low); decoded != 0xFFFD {
+		return decoded, nil
+	}
+	return 0, p.errorf("invalid surrogate pair")
+}
+
+func (p *parser) parseLogicalOr() (logicalExpr, error) {
+	exprs := orExpr{}
+	for {
+		expr, err := p.parseLogicalAnd()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		p.skipSpace()
+		if !p.consume("||") {
+			break
+		}
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+func (p *parser) parseLogicalAnd() (logicalExpr, error) {
+	exprs := andExpr{}
+	for {
+		expr, err := p.parseBasic()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+		p.skipSpace()
+		if !p.consume("&&") {
+			break
+		}
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+// parseBasic parses a parenthesized expression, a comparison or a test, each optionally negated
+func (p *parser) parseBasic() (logicalExpr, error) {
+	p.skipSpace()
+	if p.consume("!") {
+		p.skipSpace()
+		expr, err := p.parseNegatable()
+		if err != nil {
+			return nil, err
+		}
+		return notExpr{expr: expr}, nil
+	}
+	if p.peek() == '(' {
+		return p.parseNegatable()
+	}
+
+	left, err := p.parseOperandOrTest()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	op := p.parseComparisonOp()
+	if op == "" {
+		switch test := left.(type) {
+		case *filterQuery:
+			return existsExpr{query: test}, nil
+		case *functionCall:
+			if test.fn.result != logicalResult {
+				return nil, p.errorf("result of %s() must be compared", test.name)
+			}
+			return test, nil
+		}
+		return nil, p.errorf("expected comparison operator")
+	}
+	leftOperand, err := p.asOperand(left)
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	right, err := p.parseOperandOrTest()
+	if err != nil {
+		return nil, err
+	}
+	rightOperand, err := p.asOperand(right)
+	if err != nil {
+		return nil, err
+	}
+	return comparisonExpr{left: leftOperand, op: op, right: rightOperand}, nil
+}
+
+// parseNegatable parses what may follow !, a parenthesized expression or a test
+func (p *parser) parseNegatable() (logicalExpr, error) {
+	if p.consume("(") {
+		expr, err := p.parseLogicalOr()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpace()
+		if !p.consume(")") {
+			return nil, p.errorf("expected )")
+		}
+		return expr, nil
+	}
+	test, err := p.parseOperandOrTest()
+	if err != nil {
+		return nil, err
+	}
+	switch test := test.(type) {
+	case *filterQuery:
+		return existsExpr{query: test}, nil
+	case *functionCall:
+		if test.fn.result == logicalResult {
+			return test, nil
+		}
+	}
+	return nil, p.errorf("expected a test expression")
+}
+
+func (p *parser) parseComparisonOp() string {
+	for _, op := range []string{"==", "!=", "<=", ">=", "<", ">"} {
+		if p.consume(op) {
+			return op
+		}
+	}
+	return ""
+}
+
+// asOperand checks that a parsed expression can be compared
+func (p *parser) asOperand(expr interface{}) (operand, error) {
+	switch expr := expr.(type) {
+	case *filterQuery:
+		if !expr.singular() {
+			return nil, p.errorf("only singular queries can be compared")
+		}
+		return expr, nil
+	case *functionCall:
+		if expr.fn.result != valueResult {
+			return nil, p.errorf("result of %s() can't be compared", expr.name)
+		}
+		return expr, nil
+	case literal:
+		return expr, nil
+	}
+	return nil, p.errorf("invalid comparison operand")
+}
+
+// parseOperandOrTest parses a filter query, a function call or a literal
+func (p *parser) parseOperandOrTest() (interface{}, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		segments, err := p.parseSegments()
+		if err != nil {
+			return nil, err
+		}
+		return &filterQuery{relative: c == '@', segments: segments}, nil
+	case c == '\'' || c == '"':
+		s, err := p.parseString()
+		return literal{v: s}, err
+	case c == '-' || isDigit(c):
+		return p.parseNumber()
+	}
+	for _, keyword := range []string{"true", "false", "null"} {
+		if strings.HasPrefix(p.src[p.pos:], keyword) && !isFunctionChar(p.at(p.pos+len(keyword))) {
+			p.pos += len(keyword)
+			switch keyword {
+			case "true":
+				return literal{v: true}, nil
+			case "false":
+				return literal{v: false}, nil
+			}
+			return literal{v: nil}, nil
+		}
+	}
+	return p.parseFunction()
+}
+
+func (p *parser) at(pos int) byte {
+	if pos >= len(p.src) {
+		return 0
+	}
+	return p.src[pos]
+}
+
+func isFunctionChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c == '_' || isDigit(c)
+}
+
+func (p *parser) parseNumber() (interface{}, error) {
+	start := p.pos
+	p.consume("-")
+	intStart := p.pos
+	for isDigit(p.peek()) {
+		p.pos++
+	}
+	if p.pos == intStart || (p.src[intStart] == '0' && p.pos-intStart > 1) {
+		p.pos = start
+		return nil, p.errorf("invalid number")
+	}
+	if p.consume(".") {
+		fracStart := p.pos
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+		if p.pos == fracStart {
+			return nil, p.errorf("invalid number")
+		}
+	}
+	if p.peek() == 'e' || p.peek() == 'E' {
+		p.pos++
+		if p.peek() == '+' || p.peek() == '-' {
+			p.pos++
+		}
+		expStart := p.pos
+		for isDigit(p.peek()) {
+			p.pos++
+		}
+		if p.pos == expStart {
+			return nil, p.errorf("invalid number")
+		}
+	}
+	value, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+	if err != nil {
+		return nil, p.errorf("invalid number")
+	}
+	return literal{v: value}, nil
+}
+
+func (p *parser) parseFunction() (interface{}, error) {
+	start := p.pos
+	if p.peek() < 'a' || p.peek() > 'z' {
+		return nil, p.errorf("unexpected character %q", p.peek())
+	}
+	for isFunctionChar(p.peek()) {
+		p.pos++
+	}
+	name := p.src[start:p.pos]
+	fn, ok := functions[name]
+	if !ok {
+		p.pos = start
+		return nil, p.errorf("unknown function %q", name)
+	}
+	if !p.consume("(") {
+		return nil, p.errorf("expected ( after %s", name)
+	}
+	call := &functionCall{name: name, fn: fn}
+	for {
+		p.skipSpace()
+		if len(call.args) == 0 && p.consume(")") {
+			break
+		}
+		arg, err := p.parseOperandOrTest()
+		if err != nil {
+			return nil, err
+		}
+		if len(call.args) >= len(fn.params) {
+			return nil, p.errorf("too many arguments for %s()", name)
+		}
+		if err := p.checkArgument(call, fn.params[len(call.args)], arg); err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		p.skipSpace()
+		if p.consume(")") {
+			break
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected , or )")
+		}
+	}
+	if len(call.args) != len(fn.params) {
+		return nil, p.errorf("%s() takes %d arguments", name, len(fn.params))
+	}
+	return call, nil
+}
+
+func (p *parser) checkArgument(call *functionCall, param paramType, arg interface{}) error {
+	if param == nodesParam {
+		if _, ok := arg.(*filterQuery); !ok {
+			return p.errorf("argument of %s() must be a query", call.name)
+		}
+		return nil
+	}
+	if _, err := p.asOperand(arg); err != nil {
+		return errors.New("invalid argument of " + call.name + "(): " + err.Error())
+	}
+	return nil
+}
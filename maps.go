@@ -1,9 +1,9 @@
 package gofp
 
 import (
+	"errors"
 	"reflect"
 	"strconv"
-	"strings"
 )
 
 // Keys returns all the keys of any map
@@ -96,7 +96,10 @@ func Extend(initialMap map[string]interface{}, extendingMap map[string]interface
 	return newMap
 }
 
-//Get returns the value by path, if path is invalid returns nil
+// Get returns the value by path, if path is invalid returns nil. Path segments are separated by dots,
+// a backslash escapes the next character and brackets hold an index like [0] or a quoted key like ["a.b"].
+// Negative indexes count from the end of a slice. Wildcards are only supported by GetAll.
+// CompileQuery and the typed getters such as GetIntE report why a path couldn't be resolved
 func Get(args ...interface{}) interface{} {
 	if len(args) < 2 {
 		panic("Invalid number of argument. Atleast 2 arguments are required")
@@ -115,48 +118,364 @@ func Get(args ...interface{}) interface{} {
 	if len(args) >= 3 {
 		fallback = args[2]
 	}
-	if mapData == nil {
+	data, found := resolve(mapData, path)
+	if !found {
 		return fallback
 	}
-	defer func() interface{} {
-		if r := recover(); r != nil {
-			return fallback
+	return data
+}
+
+// resolve walks the path through maps, slices, structs and strings, found is false if any part of the path doesn't exist
+func resolve(data interface{}, path string) (interface{}, bool) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, false
+	}
+	return resolveSegments(data, segments)
+}
+
+func resolveSegments(data interface{}, segments []pathSegment) (interface{}, bool) {
+	if data == nil {
+		return nil, false
+	}
+	for _, segment := range segments {
+		if segment.kind != segmentKey {
+			return nil, false
 		}
-		return fallback
-	}()
-	data := mapData
-	paths := strings.Split(path, ".")
-	for _, key := range paths {
-		value := reflect.ValueOf(data)
-		dataType := value.Type().Kind()
-		if dataType == reflect.Map {
-			data = data.(map[string]interface{})[key]
-			continue
-		}
-
-		if dataType == reflect.Slice {
-			indx, err := strconv.Atoi(key)
-			if err != nil {
-				return fallback
-			}
-			data = value.Index(indx).Interface().(interface{})
-			continue
+		value, _, found := lookupKey(data, segment.key)
+		if !found {
+			return nil, false
 		}
+		data = value
+	}
+	return data, true
+}
 
-		if dataType == reflect.Struct {
-			data = value.FieldByName(key).Interface().(interface{})
-			continue
+// lookupKey returns the value stored under key in a map, slice, struct or string together with
+// the concrete key, which differs from key for negative slice indexes
+func lookupKey(data interface{}, key string) (interface{}, string, bool) {
+	if mapData, ok := data.(map[string]interface{}); ok {
+		value, exists := mapData[key]
+		return value, key, exists
+	}
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, key, false
 		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return nil, key, false
+		}
+		item := value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key()))
+		if !item.IsValid() {
+			return nil, key, false
+		}
+		return item.Interface(), key, true
+	case reflect.Slice, reflect.Array:
+		indx, ok := sliceIndex(key, value.Len())
+		if !ok {
+			return nil, key, false
+		}
+		return value.Index(indx).Interface(), strconv.Itoa(indx), true
+	case reflect.Struct:
+		field, ok := fieldByName(value, key)
+		if !ok || !field.CanInterface() {
+			return nil, key, false
+		}
+		return field.Interface(), key, true
+	case reflect.String:
+		indx, ok := sliceIndex(key, value.Len())
+		if !ok {
+			return nil, key, false
+		}
+		return string(value.String()[indx]), strconv.Itoa(indx), true
+	}
+	return nil, key, false
+}
+
+// fieldByName returns the field of a struct named key including promoted fields, ok is false if there
+// is no such field or it's promoted through a nil embedded pointer
+func fieldByName(value reflect.Value, key string) (reflect.Value, bool) {
+	field, ok := value.Type().FieldByName(key)
+	if !ok {
+		return reflect.Value{}, false
+	}
+	return fieldByIndex(value, field.Index)
+}
+
+// Set returns a copy of the map with the value stored at the path. Missing intermediate maps are
+// created and slices grow to fit the index. Only the maps and slices along the path are copied.
+// Values along the path which are neither maps with string keys nor slices, such as structs, are
+// replaced by a new map. Set panics with a *PathError if the path is invalid or a value can't be
+// stored in a map or slice of another element type, SetE returns the error instead
+func Set(mapData map[string]interface{}, path string, value interface{}) map[string]interface{} {
+	return Update(mapData, path, func(interface{}) interface{} {
+		return value
+	})
+}
+
+// SetInPlace works like Set but modifies the given map and the maps and slices along the path
+func SetInPlace(mapData map[string]interface{}, path string, value interface{}) map[string]interface{} {
+	return UpdateInPlace(mapData, path, func(interface{}) interface{} {
+		return value
+	})
+}
+
+// SetE works like Set but returns a *PathError instead of panicking. Values along the path which are
+// neither maps with string keys nor slices are reported as a *TypeMismatchError instead of being replaced
+func SetE(mapData map[string]interface{}, path string, value interface{}) (map[string]interface{}, error) {
+	return UpdateE(mapData, path, func(interface{}) interface{} {
+		return value
+	})
+}
+
+// Update returns a copy of the map with the value at the path replaced by the result of fn.
+// fn receives nil if there is no value at the path. Like Set, it replaces values which are neither
+// maps nor slices by a new map and panics with a *PathError if the result can't be stored
+func Update(mapData map[string]interface{}, path string, fn func(interface{}) interface{}) map[string]interface{} {
+	return mustUpdate(mapData, path, fn, false)
+}
+
+// UpdateInPlace works like Update but modifies the given map and the maps and slices along the path
+func UpdateInPlace(mapData map[string]interface{}, path string, fn func(interface{}) interface{}) map[string]interface{} {
+	return mustUpdate(mapData, path, fn, true)
+}
+
+// UpdateE works like Update but returns the errors described by SetE instead of panicking
+func UpdateE(mapData map[string]interface{}, path string, fn func(interface{}) interface{}) (map[string]interface{}, error) {
+	keys, err := pathKeys(path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := pathUpdate{path: path, keys: keys, fn: fn, strict: true}.setIn(mapData, 0)
+	if err != nil {
+		return nil, err
+	}
+	return data.(map[string]interface{}), nil
+}
 
-		if dataType == reflect.String {
-			indx, err := strconv.Atoi(key)
-			if err != nil {
-				return fallback
+func mustUpdate(mapData map[string]interface{}, path string, fn func(interface{}) interface{}, inPlace bool) map[string]interface{} {
+	keys, err := pathKeys(path)
+	if err != nil {
+		panic(err)
+	}
+	data, err := pathUpdate{path: path, keys: keys, fn: fn, inPlace: inPlace}.setIn(mapData, 0)
+	if err != nil {
+		panic(err)
+	}
+	return data.(map[string]interface{})
+}
+
+// Unset returns a copy of the map without the value at the path, elements removed from a slice
+// shift the following elements. The map is returned unchanged if the path doesn't exist
+func Unset(mapData map[string]interface{}, path string) map[string]interface{} {
+	return mustUnset(mapData, path, false)
+}
+
+// UnsetInPlace works like Unset but modifies the given map and the maps and slices along the path
+func UnsetInPlace(mapData map[string]interface{}, path string) map[string]interface{} {
+	return mustUnset(mapData, path, true)
+}
+
+func mustUnset(mapData map[string]interface{}, path string, inPlace bool) map[string]interface{} {
+	keys, err := pathKeys(path)
+	if err != nil {
+		panic(err)
+	}
+	data, _ := unsetIn(mapData, keys, inPlace)
+	return data.(map[string]interface{})
+}
+
+// pathKeys parses a path for Set, Unset and Update, which don't support wildcards
+func pathKeys(path string) ([]string, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, &PathError{Path: path, Index: -1, Err: err}
+	}
+	if len(segments) == 0 {
+		return nil, &PathError{Path: path, Index: -1, Err: errors.New("path must not be empty")}
+	}
+	keys := make([]string, len(segments))
+	for i, segment := range segments {
+		if segment.kind != segmentKey {
+			return nil, &PathError{Path: path, Segment: segment.key, Index: i, Err: ErrWildcard}
+		}
+		keys[i] = segment.key
+	}
+	return keys, nil
+}
+
+// pathUpdate stores the result of fn at keys. Values along the path which are neither maps with
+// string keys nor slices are replaced by a new map, or reported if strict is set
+type pathUpdate struct {
+	path    string
+	keys    []string
+	fn      func(interface{}) interface{}
+	inPlace bool
+	strict  bool
+}
+
+// setIn stores the result of fn at the keys from depth on inside data and returns the updated data
+func (u pathUpdate) setIn(data interface{}, depth int) (interface{}, error) {
+	key := u.keys[depth]
+	fail := func(err error) (interface{}, error) {
+		return nil, &PathError{Path: u.path, Segment: key, Index: depth, Err: err}
+	}
+	value := reflect.ValueOf(data)
+	switch {
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		target := value
+		if !u.inPlace || value.IsNil() {
+			target = copyMap(value)
+		}
+		mapKey := reflect.ValueOf(key).Convert(value.Type().Key())
+		var child interface{}
+		if item := value.MapIndex(mapKey); item.IsValid() {
+			child = item.Interface()
+		}
+		next, err := u.next(child, depth)
+		if err != nil {
+			return nil, err
+		}
+		item, err := storable(next, value.Type().Elem())
+		if err != nil {
+			return fail(err)
+		}
+		target.SetMapIndex(mapKey, item)
+		return target.Interface(), nil
+	case value.Kind() == reflect.Slice:
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return fail(ErrInvalidIndex)
+		}
+		if index < 0 {
+			index += value.Len()
+		}
+		if index < 0 {
+			return fail(ErrIndexOutOfRange)
+		}
+		target := value
+		if !u.inPlace || index >= value.Len() {
+			length := value.Len()
+			if index >= length {
+				length = index + 1
 			}
-			data = string(data.(string)[indx])
-			continue
+			target = reflect.MakeSlice(value.Type(), length, length)
+			reflect.Copy(target, value)
 		}
-		return fallback
+		var child interface{}
+		if index < value.Len() {
+			child = value.Index(index).Interface()
+		}
+		next, err := u.next(child, depth)
+		if err != nil {
+			return nil, err
+		}
+		item, err := storable(next, value.Type().Elem())
+		if err != nil {
+			return fail(err)
+		}
+		target.Index(index).Set(item)
+		return target.Interface(), nil
+	case data != nil && u.strict:
+		return fail(&TypeMismatchError{Expected: "map with string keys or slice", Actual: value.Type().String()})
 	}
-	return data
+	u.inPlace = true
+	return u.setIn(map[string]interface{}{}, depth)
+}
+
+func (u pathUpdate) next(child interface{}, depth int) (interface{}, error) {
+	if depth == len(u.keys)-1 {
+		return u.fn(child), nil
+	}
+	return u.setIn(child, depth+1)
+}
+
+// unsetIn removes the value at keys inside data, removed is false if the path doesn't exist
+func unsetIn(data interface{}, keys []string, inPlace bool) (result interface{}, removed bool) {
+	key := keys[0]
+	value := reflect.ValueOf(data)
+	switch {
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		mapKey := reflect.ValueOf(key).Convert(value.Type().Key())
+		item := value.MapIndex(mapKey)
+		if !item.IsValid() {
+			return data, false
+		}
+		var child interface{}
+		if len(keys) > 1 {
+			if child, removed = unsetIn(item.Interface(), keys[1:], inPlace); !removed {
+				return data, false
+			}
+		}
+		target := value
+		if !inPlace {
+			target = copyMap(value)
+		}
+		if len(keys) == 1 {
+			target.SetMapIndex(mapKey, reflect.Value{})
+		} else {
+			target.SetMapIndex(mapKey, valueFor(child, value.Type().Elem(), key))
+		}
+		return target.Interface(), true
+	case value.Kind() == reflect.Slice:
+		index, ok := sliceIndex(key, value.Len())
+		if !ok {
+			return data, false
+		}
+		if len(keys) == 1 {
+			target := reflect.MakeSlice(value.Type(), 0, value.Len()-1)
+			if inPlace {
+				target = value.Slice(0, 0)
+			}
+			target = reflect.AppendSlice(target, value.Slice(0, index))
+			return reflect.AppendSlice(target, value.Slice(index+1, value.Len())).Interface(), true
+		}
+		child, removed := unsetIn(value.Index(index).Interface(), keys[1:], inPlace)
+		if !removed {
+			return data, false
+		}
+		target := value
+		if !inPlace {
+			target = reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+			reflect.Copy(target, value)
+		}
+		target.Index(index).Set(valueFor(child, value.Type().Elem(), key))
+		return target.Interface(), true
+	}
+	return data, false
+}
+
+func copyMap(value reflect.Value) reflect.Value {
+	newMap := reflect.MakeMapWithSize(value.Type(), value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		newMap.SetMapIndex(iter.Key(), iter.Value())
+	}
+	return newMap
+}
+
+// valueFor converts data to a value which can be stored in a map or slice of elemType
+func valueFor(data interface{}, elemType reflect.Type, key string) reflect.Value {
+	value, err := storable(data, elemType)
+	if err != nil {
+		panic("Invalid value for key " + strconv.Quote(key) + ": " + err.Error())
+	}
+	return value
+}
+
+// storable converts data to a value which can be stored in a map or slice of elemType
+func storable(data interface{}, elemType reflect.Type) (reflect.Value, error) {
+	if data == nil {
+		return reflect.Zero(elemType), nil
+	}
+	value := reflect.ValueOf(data)
+	if !value.Type().AssignableTo(elemType) {
+		return reflect.Value{}, &TypeMismatchError{Expected: elemType.String(), Actual: value.Type().String()}
+	}
+	return value, nil
 }
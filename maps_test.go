@@ -1,6 +1,8 @@
 package gofp
 
 import (
+	"errors"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -110,3 +112,193 @@ func Test_Get(t *testing.T) {
 		t.Errorf("Get() got= %v, want %v", geoLocationFromGet, geoLocation)
 	}
 }
+
+func Test_GetFallback(t *testing.T) {
+	type address struct {
+		Street string
+	}
+	data := map[string]interface{}{
+		"address":  &address{Street: "10 Downing Street"},
+		"contacts": map[string]string{"email": "johndoe@gmail.com"},
+		"tags":     []string{"admin"},
+	}
+	if Get(data, "address.Street") != "10 Downing Street" {
+		t.Errorf("Get() got= %v, want %v", Get(data, "address.Street"), "10 Downing Street")
+	}
+	if Get(data, "contacts.email") != "johndoe@gmail.com" {
+		t.Errorf("Get() got= %v, want %v", Get(data, "contacts.email"), "johndoe@gmail.com")
+	}
+	if Get(data, "contacts.phone", "n/a") != "n/a" {
+		t.Errorf("Get() got= %v, want %v", Get(data, "contacts.phone", "n/a"), "n/a")
+	}
+	if Get(data, "tags.5", "n/a") != "n/a" {
+		t.Errorf("Get() got= %v, want %v", Get(data, "tags.5", "n/a"), "n/a")
+	}
+}
+
+func Test_GetNilEmbeddedPointer(t *testing.T) {
+	type inner struct {
+		X int
+	}
+	type outer struct {
+		*inner
+		Y int
+	}
+	if got := Get(outer{Y: 1}, "X", "n/a"); got != "n/a" {
+		t.Errorf("Get() got= %v, want %v", got, "n/a")
+	}
+	if got := Get(outer{inner: &inner{X: 2}}, "X", "n/a"); got != 2 {
+		t.Errorf("Get() got= %v, want %v", got, 2)
+	}
+	if _, err := GetIntE(outer{Y: 1}, "X"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("GetIntE() error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if MustCompileQuery("X").Exists(outer{Y: 1}) {
+		t.Errorf("Query.Exists() = %v, want %v", true, false)
+	}
+}
+
+func Test_Set(t *testing.T) {
+	data := map[string]interface{}{
+		"name": "John",
+		"contacts": map[string]interface{}{
+			"emails": []interface{}{"johndoe@gmail.com"},
+		},
+	}
+	updated := Set(data, "contacts.emails.2", "john@doe.com")
+	emails := Get(updated, "contacts.emails").([]interface{})
+	if len(emails) != 3 || emails[1] != nil || emails[2] != "john@doe.com" {
+		t.Errorf("Set() got= %v, want %v", emails, []interface{}{"johndoe@gmail.com", nil, "john@doe.com"})
+	}
+	if len(Get(data, "contacts.emails").([]interface{})) != 1 {
+		t.Errorf("Set() modified the original data: %v", data)
+	}
+	created := Set(data, "address.geo.lat", 51.5)
+	if Get(created, "address.geo.lat") != 51.5 || Has(data, "address") {
+		t.Errorf("Set() got= %v, want %v", Get(created, "address.geo.lat"), 51.5)
+	}
+}
+
+func Test_SetReplacesNonContainers(t *testing.T) {
+	type point struct {
+		X int
+		Y int
+	}
+	data := map[string]interface{}{"a": point{X: 1, Y: 1}}
+	updated := Set(data, "a.Y", 2)
+	want := map[string]interface{}{"a": map[string]interface{}{"Y": 2}}
+	if !reflect.DeepEqual(updated, want) {
+		t.Errorf("Set() got= %v, want %v", updated, want)
+	}
+	var typeErr *TypeMismatchError
+	if _, err := SetE(data, "a.Y", 2); !errors.As(err, &typeErr) || typeErr.Actual != "gofp.point" {
+		t.Errorf("SetE() error = %v, want a type mismatch for %v", err, "gofp.point")
+	}
+}
+
+func Test_SetTypedContainers(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string]interface{}
+		path    string
+		missing string
+	}{
+		{"map", map[string]interface{}{"a": map[string]string{"b": "x"}}, "a.b.c", "a.z.c"},
+		{"slice", map[string]interface{}{"a": []string{"x"}}, "a.0.c", "a.3.c"},
+	}
+	for _, tt := range tests {
+		var pathErr *PathError
+		_, err := SetE(tt.data, tt.missing, 1)
+		if !errors.As(err, &pathErr) || pathErr.Index != 1 {
+			t.Errorf("SetE() %v error = %v, want a path error at segment %v", tt.name, err, 1)
+		}
+		func() {
+			defer func() {
+				if _, ok := recover().(*PathError); !ok {
+					t.Errorf("Set() %v didn't panic with a *PathError", tt.name)
+				}
+			}()
+			Set(tt.data, tt.path, 1)
+		}()
+	}
+	updated, err := SetE(map[string]interface{}{"a": map[string]string{"b": "x"}}, "a.b", "y")
+	if err != nil || Get(updated, "a.b") != "y" {
+		t.Errorf("SetE() got= %v, %v, want %v", Get(updated, "a.b"), err, "y")
+	}
+}
+
+func Test_SetInPlace(t *testing.T) {
+	data := map[string]interface{}{
+		"contacts": map[string]string{"email": "johndoe@gmail.com"},
+	}
+	SetInPlace(data, "contacts.email", "john@doe.com")
+	if Get(data, "contacts.email") != "john@doe.com" {
+		t.Errorf("SetInPlace() got= %v, want %v", Get(data, "contacts.email"), "john@doe.com")
+	}
+}
+
+func Test_Update(t *testing.T) {
+	data := map[string]interface{}{
+		"stats": map[string]interface{}{"visits": 1},
+	}
+	increment := func(value interface{}) interface{} {
+		if value == nil {
+			return 1
+		}
+		return value.(int) + 1
+	}
+	updated := Update(Update(data, "stats.visits", increment), "stats.likes", increment)
+	if Get(updated, "stats.visits") != 2 || Get(updated, "stats.likes") != 1 || Get(data, "stats.visits") != 1 {
+		t.Errorf("Update() got= %v, want %v", updated, map[string]interface{}{"stats": map[string]interface{}{"visits": 2, "likes": 1}})
+	}
+}
+
+func Test_UpdateInPlace(t *testing.T) {
+	data := map[string]interface{}{"tags": []string{"admin"}}
+	UpdateInPlace(data, "tags.0", func(value interface{}) interface{} {
+		return strings.ToUpper(value.(string))
+	})
+	if Get(data, "tags.0") != "ADMIN" {
+		t.Errorf("UpdateInPlace() got= %v, want %v", Get(data, "tags.0"), "ADMIN")
+	}
+}
+
+func Test_Unset(t *testing.T) {
+	data := map[string]interface{}{
+		"name": "John",
+		"contacts": map[string]interface{}{
+			"emails": []interface{}{"a@gmail.com", "b@gmail.com", "c@gmail.com"},
+			"fax":    "+44-208-1234567",
+		},
+	}
+	updated := Unset(Unset(data, "contacts.fax"), "contacts.emails.1")
+	if Has(updated["contacts"].(map[string]interface{}), "fax") || !Has(data["contacts"].(map[string]interface{}), "fax") {
+		t.Errorf("Unset() got= %v, want %v", Has(updated["contacts"].(map[string]interface{}), "fax"), false)
+	}
+	emails := Get(updated, "contacts.emails").([]interface{})
+	if len(emails) != 2 || emails[1] != "c@gmail.com" || len(Get(data, "contacts.emails").([]interface{})) != 3 {
+		t.Errorf("Unset() got= %v, want %v", emails, []interface{}{"a@gmail.com", "c@gmail.com"})
+	}
+	if unchanged := Unset(data, "contacts.phone.home"); len(unchanged) != 2 {
+		t.Errorf("Unset() got= %v, want %v", unchanged, data)
+	}
+}
+
+func Test_UnsetInPlace(t *testing.T) {
+	data := map[string]interface{}{"name": "John", "age": 30}
+	UnsetInPlace(data, "age")
+	if Has(data, "age") {
+		t.Errorf("UnsetInPlace() got= %v, want %v", Has(data, "age"), false)
+	}
+}
+
+func Test_SetPathSyntax(t *testing.T) {
+	data := map[string]interface{}{"items": []interface{}{1, 2, 3}}
+	updated := Set(Set(data, "items[-1]", 30), `hosts["example.com"].port`, 443)
+	if Get(updated, "items.2") != 30 || Get(updated, `hosts.example\.com.port`) != 443 {
+		t.Errorf("Set() got= %v, want %v", updated, map[string]interface{}{"items": []interface{}{1, 2, 30}, "hosts": map[string]interface{}{"example.com": map[string]interface{}{"port": 443}}})
+	}
+	if removed := Unset(data, "items[-1]"); len(removed["items"].([]interface{})) != 2 {
+		t.Errorf("Unset() got= %v, want %v", removed["items"], []interface{}{1, 2})
+	}
+}
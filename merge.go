@@ -0,0 +1,236 @@
+package gofp
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// SliceStrategy defines how MergeWith combines two slices found under the same key. The result keeps
+// the type of the slices when both have the same type and the merged items fit, otherwise it's a []interface{}
+type SliceStrategy int
+
+const (
+	// SliceReplace keeps the incoming slice, like Extend does
+	SliceReplace SliceStrategy = iota
+	// SliceAppend appends the incoming elements to the current ones
+	SliceAppend
+	// SliceUnion appends the incoming elements and keeps every element once, like Uniq
+	SliceUnion
+	// SliceMergeByIndex merges the elements at the same index
+	SliceMergeByIndex
+	// SliceMergeByKey merges the maps sharing the same value of the key field and appends the others
+	SliceMergeByKey
+)
+
+// ConflictResolver returns the value to keep when a key exists in both maps and the values can't be merged
+type ConflictResolver func(path string, current interface{}, incoming interface{}) interface{}
+
+type mergeConfig struct {
+	slices   SliceStrategy
+	sliceKey string
+	resolver ConflictResolver
+}
+
+// MergeOption configures MergeWith
+type MergeOption func(*mergeConfig)
+
+// WithSliceStrategy sets how slices under the same key are combined, by default the incoming slice replaces the current one
+func WithSliceStrategy(strategy SliceStrategy) MergeOption {
+	return func(config *mergeConfig) {
+		config.slices = strategy
+	}
+}
+
+// WithSliceKey merges slices of maps by the value of the given field, see SliceMergeByKey
+func WithSliceKey(field string) MergeOption {
+	return func(config *mergeConfig) {
+		config.slices = SliceMergeByKey
+		config.sliceKey = field
+	}
+}
+
+// WithConflictResolver sets the function deciding the value of a key present in both maps when the
+// values are neither both maps nor both slices. By default the incoming value wins
+func WithConflictResolver(resolver ConflictResolver) MergeOption {
+	return func(config *mergeConfig) {
+		config.resolver = resolver
+	}
+}
+
+// MergeWith returns a function which deeply merges any number of maps from left to right into a new map.
+// Maps with string keys of any value type, such as map[string]string, are merged like map[string]interface{}
+func MergeWith(opts ...MergeOption) func(maps ...map[string]interface{}) map[string]interface{} {
+	config := mergeConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return func(maps ...map[string]interface{}) map[string]interface{} {
+		merged := map[string]interface{}{}
+		for _, mapData := range maps {
+			merged = config.mergeMaps(nil, merged, mapData)
+		}
+		return merged
+	}
+}
+
+func (config mergeConfig) mergeMaps(path []string, current map[string]interface{}, incoming map[string]interface{}) map[string]interface{} {
+	newMap := make(map[string]interface{}, len(current)+len(incoming))
+	for key, value := range current {
+		newMap[key] = value
+	}
+	for key, value := range incoming {
+		if existing, exists := current[key]; exists {
+			newMap[key] = config.mergeValues(append(path[:len(path):len(path)], key), existing, value)
+		} else {
+			newMap[key] = value
+		}
+	}
+	return newMap
+}
+
+func (config mergeConfig) mergeValues(path []string, current interface{}, incoming interface{}) interface{} {
+	if currentMap, ok := asStringMap(current); ok {
+		if incomingMap, ok := asStringMap(incoming); ok {
+			return config.mergeMaps(path, currentMap, incomingMap)
+		}
+	}
+	currentSlice, currentIsSlice := sliceValue(current)
+	incomingSlice, incomingIsSlice := sliceValue(incoming)
+	if currentIsSlice && incomingIsSlice {
+		return config.mergeSlices(path, currentSlice, incomingSlice)
+	}
+	if config.resolver != nil {
+		return config.resolver(formatPath(path), current, incoming)
+	}
+	return incoming
+}
+
+func (config mergeConfig) mergeSlices(path []string, current reflect.Value, incoming reflect.Value) interface{} {
+	switch config.slices {
+	case SliceAppend:
+		return appendValues(current, incoming, false)
+	case SliceUnion:
+		return appendValues(current, incoming, true)
+	case SliceMergeByIndex:
+		length := current.Len()
+		if incoming.Len() > length {
+			length = incoming.Len()
+		}
+		merged := make([]interface{}, length)
+		for i := range merged {
+			switch {
+			case i >= incoming.Len():
+				merged[i] = current.Index(i).Interface()
+			case i >= current.Len():
+				merged[i] = incoming.Index(i).Interface()
+			default:
+				merged[i] = config.mergeValues(append(path[:len(path):len(path)], strconv.Itoa(i)), current.Index(i).Interface(), incoming.Index(i).Interface())
+			}
+		}
+		return typedValues(merged, current, incoming)
+	case SliceMergeByKey:
+		merged := interfaceValues(current)
+		positions := map[interface{}]int{}
+		for i, item := range merged {
+			if key, ok := mergeKey(item, config.sliceKey); ok {
+				positions[key] = i
+			}
+		}
+		for _, item := range interfaceValues(incoming) {
+			key, ok := mergeKey(item, config.sliceKey)
+			if position, exists := positions[key]; ok && exists {
+				merged[position] = config.mergeValues(append(path[:len(path):len(path)], strconv.Itoa(position)), merged[position], item)
+				continue
+			}
+			if ok {
+				positions[key] = len(merged)
+			}
+			merged = append(merged, item)
+		}
+		return typedValues(merged, current, incoming)
+	}
+	return incoming.Interface()
+}
+
+// appendValues appends incoming to current keeping the slice type when both have the same type.
+// With unique the result holds every item once, compared the same way as Uniq
+func appendValues(current reflect.Value, incoming reflect.Value, unique bool) interface{} {
+	merged := reflect.ValueOf([]interface{}{})
+	if current.Type() == incoming.Type() {
+		merged = reflect.MakeSlice(current.Type(), 0, current.Len()+incoming.Len())
+	}
+	seen := newItemSet(nil)
+	for _, items := range []reflect.Value{current, incoming} {
+		for i := 0; i < items.Len(); i++ {
+			item := items.Index(i)
+			if unique {
+				if seen.has(item.Interface()) {
+					continue
+				}
+				seen.add(item.Interface())
+			}
+			merged = reflect.Append(merged, item)
+		}
+	}
+	return merged.Interface()
+}
+
+// typedValues converts merged to the type of current and incoming when both have the same type and
+// every merged item fits into it, otherwise merged is returned as it is
+func typedValues(merged []interface{}, current reflect.Value, incoming reflect.Value) interface{} {
+	if current.Type() != incoming.Type() {
+		return merged
+	}
+	typed := reflect.MakeSlice(current.Type(), len(merged), len(merged))
+	for i, item := range merged {
+		value, err := storable(item, current.Type().Elem())
+		if err != nil {
+			return merged
+		}
+		typed.Index(i).Set(value)
+	}
+	return typed.Interface()
+}
+
+// mergeKey returns the value of the key field of a map, ok is false if it's missing or not comparable
+func mergeKey(item interface{}, field string) (interface{}, bool) {
+	mapData, ok := asStringMap(item)
+	if !ok {
+		return nil, false
+	}
+	key, exists := mapData[field]
+	if !exists || key == nil || !hashable(key) {
+		return nil, false
+	}
+	return key, true
+}
+
+// asStringMap returns any map with string keys as map[string]interface{}
+func asStringMap(data interface{}) (map[string]interface{}, bool) {
+	if mapData, ok := data.(map[string]interface{}); ok {
+		return mapData, true
+	}
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	mapData := make(map[string]interface{}, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		mapData[iter.Key().String()] = iter.Value().Interface()
+	}
+	return mapData, true
+}
+
+func sliceValue(data interface{}) (reflect.Value, bool) {
+	value := reflect.ValueOf(data)
+	return value, value.Kind() == reflect.Slice
+}
+
+func interfaceValues(slice reflect.Value) []interface{} {
+	items := make([]interface{}, slice.Len())
+	for i := range items {
+		items[i] = slice.Index(i).Interface()
+	}
+	return items
+}
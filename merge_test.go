@@ -0,0 +1,121 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_MergeWith(t *testing.T) {
+	defaults := map[string]interface{}{
+		"port":  8080,
+		"hosts": []string{"a"},
+		"log":   map[string]interface{}{"level": "info", "format": "json"},
+	}
+	file := map[string]interface{}{
+		"hosts": []string{"b"},
+		"log":   map[string]string{"level": "debug"},
+	}
+	env := map[string]interface{}{"port": 9090}
+	got := MergeWith()(defaults, file, env)
+	want := map[string]interface{}{
+		"port":  9090,
+		"hosts": []string{"b"},
+		"log":   map[string]interface{}{"level": "debug", "format": "json"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeWith() = %v, want %v", got, want)
+	}
+	if defaults["port"] != 8080 {
+		t.Errorf("MergeWith() modified the original map: %v", defaults)
+	}
+}
+
+func Test_WithSliceStrategy(t *testing.T) {
+	current := map[string]interface{}{"tags": []string{"a", "b"}, "ports": []interface{}{80, map[string]interface{}{"tls": false}}, "ids": []int{1, 1, 2}}
+	incoming := map[string]interface{}{"tags": []string{"b", "c"}, "ids": []int{2, 3, 3}, "ports": []interface{}{81, map[string]interface{}{"cert": "x"}, 443}}
+	tests := []struct {
+		strategy SliceStrategy
+		key      string
+		want     interface{}
+	}{
+		{SliceReplace, "tags", []string{"b", "c"}},
+		{SliceAppend, "tags", []string{"a", "b", "b", "c"}},
+		{SliceUnion, "tags", []string{"a", "b", "c"}},
+		{SliceUnion, "ids", []int{1, 2, 3}},
+		{SliceUnion, "ports", []interface{}{80, map[string]interface{}{"tls": false}, 81, map[string]interface{}{"cert": "x"}, 443}},
+		{SliceMergeByIndex, "ports", []interface{}{81, map[string]interface{}{"tls": false, "cert": "x"}, 443}},
+	}
+	for _, tt := range tests {
+		got := MergeWith(WithSliceStrategy(tt.strategy))(current, incoming)[tt.key]
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MergeWith(%v) = %v, want %v", tt.strategy, got, tt.want)
+		}
+	}
+}
+
+func Test_WithSliceStrategyTypedSlices(t *testing.T) {
+	current := map[string]interface{}{
+		"tags":  []string{"a", "b"},
+		"users": []map[string]interface{}{{"id": 1, "name": "Ron"}},
+	}
+	incoming := map[string]interface{}{
+		"tags":  []string{"c"},
+		"users": []map[string]interface{}{{"id": 1, "age": 30}, {"id": 2}},
+	}
+	tests := []struct {
+		option MergeOption
+		key    string
+		want   interface{}
+	}{
+		{WithSliceStrategy(SliceReplace), "tags", []string{"c"}},
+		{WithSliceStrategy(SliceAppend), "tags", []string{"a", "b", "c"}},
+		{WithSliceStrategy(SliceUnion), "tags", []string{"a", "b", "c"}},
+		{WithSliceStrategy(SliceMergeByIndex), "tags", []string{"c", "b"}},
+		{WithSliceKey("id"), "tags", []string{"a", "b", "c"}},
+		{WithSliceKey("id"), "users", []map[string]interface{}{{"id": 1, "name": "Ron", "age": 30}, {"id": 2}}},
+	}
+	for _, tt := range tests {
+		got := MergeWith(tt.option)(current, incoming)[tt.key]
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("MergeWith() %v = %#v, want %#v", tt.key, got, tt.want)
+		}
+	}
+}
+
+func Test_WithSliceKey(t *testing.T) {
+	current := map[string]interface{}{"users": []interface{}{
+		map[string]interface{}{"id": 1, "name": "Ron"},
+		map[string]interface{}{"id": 2, "name": "Sofia"},
+	}}
+	incoming := map[string]interface{}{"users": []interface{}{
+		map[string]interface{}{"id": 2, "age": 20},
+		map[string]interface{}{"id": 3, "name": "Roni"},
+	}}
+	got := MergeWith(WithSliceKey("id"))(current, incoming)["users"]
+	want := []interface{}{
+		map[string]interface{}{"id": 1, "name": "Ron"},
+		map[string]interface{}{"id": 2, "name": "Sofia", "age": 20},
+		map[string]interface{}{"id": 3, "name": "Roni"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeWith() = %v, want %v", got, want)
+	}
+}
+
+func Test_WithConflictResolver(t *testing.T) {
+	conflicts := []string{}
+	keepLarger := func(path string, current interface{}, incoming interface{}) interface{} {
+		conflicts = append(conflicts, path)
+		if current.(int) > incoming.(int) {
+			return current
+		}
+		return incoming
+	}
+	got := MergeWith(WithConflictResolver(keepLarger))(
+		map[string]interface{}{"limits": map[string]interface{}{"cpu": 4, "memory": 512}},
+		map[string]interface{}{"limits": map[string]interface{}{"cpu": 2, "memory": 1024}},
+	)
+	if Get(got, "limits.cpu") != 4 || Get(got, "limits.memory") != 1024 || len(conflicts) != 2 {
+		t.Errorf("MergeWith() = %v, want %v", got, map[string]interface{}{"limits": map[string]interface{}{"cpu": 4, "memory": 1024}})
+	}
+}
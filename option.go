@@ -0,0 +1,204 @@
+package gofp
+
+import "fmt"
+
+// Option holds a value which may or may not be present
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some returns an Option holding the given value
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, some: true}
+}
+
+// None returns an empty Option
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// IsSome returns true if the Option holds a value
+func (o Option[T]) IsSome() bool {
+	return o.some
+}
+
+// IsNone returns true if the Option is empty
+func (o Option[T]) IsNone() bool {
+	return !o.some
+}
+
+// Get returns the value and true if present, the zero value and false otherwise
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.some
+}
+
+// Unwrap returns the value, it panics if the Option is empty
+func (o Option[T]) Unwrap() T {
+	if !o.some {
+		panic("Unwrap called on an empty Option")
+	}
+	return o.value
+}
+
+// UnwrapOr returns the value if present and fallback otherwise
+func (o Option[T]) UnwrapOr(fallback T) T {
+	if !o.some {
+		return fallback
+	}
+	return o.value
+}
+
+// OrElse returns the Option itself if it holds a value, otherwise the Option returned by fn
+func (o Option[T]) OrElse(fn func() Option[T]) Option[T] {
+	if o.some {
+		return o
+	}
+	return fn()
+}
+
+// MapOption returns an Option holding the transformed value, or an empty Option if there was no value
+func MapOption[T, U any](o Option[T], fn func(value T) U) Option[U] {
+	if !o.some {
+		return None[U]()
+	}
+	return Some(fn(o.value))
+}
+
+// FlatMapOption returns the Option produced by fn, or an empty Option if there was no value
+func FlatMapOption[T, U any](o Option[T], fn func(value T) Option[U]) Option[U] {
+	if !o.some {
+		return None[U]()
+	}
+	return fn(o.value)
+}
+
+// Result holds either a value or the error which prevented producing it
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding the given value
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a failed Result holding the given error
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("Err called with a nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// ResultOf returns a Result from the conventional value and error pair
+func ResultOf[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Ok(value)
+}
+
+// IsOk returns true if the Result holds a value
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result holds an error
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error of the Result, nil if it holds a value
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the value and the error of the Result
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Unwrap returns the value, it panics if the Result holds an error
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("Unwrap called on a failed Result: %v", r.err))
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value if the Result holds one and fallback otherwise
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// OrElse returns the Result itself if it holds a value, otherwise the Result returned by fn
+func (r Result[T]) OrElse(fn func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return fn(r.err)
+}
+
+// Option returns an Option holding the value, or an empty Option if the Result holds an error
+func (r Result[T]) Option() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// MapResult returns a Result holding the transformed value, or the original error
+func MapResult[T, U any](r Result[T], fn func(value T) U) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return Ok(fn(r.value))
+}
+
+// FlatMapResult returns the Result produced by fn, or the original error
+func FlatMapResult[T, U any](r Result[T], fn func(value T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return fn(r.value)
+}
+
+// FindOpt works like Find but returns an empty Option when no item satisfies the condition
+func FindOpt(items []interface{}, fn func(index int, item interface{}) bool) Option[interface{}] {
+	for index, value := range items {
+		if fn(index, value) {
+			return Some(value)
+		}
+	}
+	return None[interface{}]()
+}
+
+// HeadOpt returns the first item of slice, or an empty Option if the slice is empty
+func HeadOpt(items []interface{}) Option[interface{}] {
+	if len(items) >= 1 {
+		return Some(items[0])
+	}
+	return None[interface{}]()
+}
+
+// TailOpt returns the last item of slice, or an empty Option if the slice is empty
+func TailOpt(items []interface{}) Option[interface{}] {
+	if len(items) >= 1 {
+		return Some(items[len(items)-1])
+	}
+	return None[interface{}]()
+}
+
+// GetOpt returns the value by path, or an empty Option if the path doesn't exist
+func GetOpt(data interface{}, path string) Option[interface{}] {
+	value, found := resolve(data, path)
+	if !found {
+		return None[interface{}]()
+	}
+	return Some(value)
+}
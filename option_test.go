@@ -0,0 +1,140 @@
+package gofp
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func Test_Some(t *testing.T) {
+	option := Some(10)
+	if !option.IsSome() || option.IsNone() || option.Unwrap() != 10 {
+		t.Errorf("Some() = %v, want %v", option.Unwrap(), 10)
+	}
+}
+
+func Test_None(t *testing.T) {
+	option := None[int]()
+	if option.IsSome() || !option.IsNone() || option.UnwrapOr(5) != 5 {
+		t.Errorf("None() = %v, want %v", option.UnwrapOr(5), 5)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("None().Unwrap() didn't panic")
+		}
+	}()
+	option.Unwrap()
+}
+
+func Test_OptionOrElse(t *testing.T) {
+	got := None[string]().OrElse(func() Option[string] {
+		return Some("fallback")
+	})
+	if got.Unwrap() != "fallback" {
+		t.Errorf("OrElse() = %v, want %v", got.Unwrap(), "fallback")
+	}
+}
+
+func Test_MapOption(t *testing.T) {
+	got := MapOption(Some(21), func(value int) string {
+		return strconv.Itoa(value * 2)
+	})
+	if got.Unwrap() != "42" {
+		t.Errorf("MapOption() = %v, want %v", got.Unwrap(), "42")
+	}
+	if MapOption(None[int](), strconv.Itoa).IsSome() {
+		t.Errorf("MapOption() = %v, want %v", true, false)
+	}
+}
+
+func Test_FlatMapOption(t *testing.T) {
+	positive := func(value int) Option[int] {
+		if value > 0 {
+			return Some(value)
+		}
+		return None[int]()
+	}
+	if FlatMapOption(Some(-1), positive).IsSome() {
+		t.Errorf("FlatMapOption() = %v, want %v", true, false)
+	}
+}
+
+func Test_Result(t *testing.T) {
+	result := ResultOf(strconv.Atoi("42"))
+	if !result.IsOk() || result.Unwrap() != 42 {
+		t.Errorf("ResultOf() = %v, want %v", result.Unwrap(), 42)
+	}
+	failed := ResultOf(strconv.Atoi("abc"))
+	if !failed.IsErr() || failed.Err() == nil || failed.UnwrapOr(-1) != -1 || failed.Option().IsSome() {
+		t.Errorf("ResultOf() error = %v, want an error", failed.Err())
+	}
+}
+
+func Test_ResultOrElse(t *testing.T) {
+	errNotFound := errors.New("not found")
+	got := Err[int](errNotFound).OrElse(func(err error) Result[int] {
+		if errors.Is(err, errNotFound) {
+			return Ok(0)
+		}
+		return Err[int](err)
+	})
+	if got.Unwrap() != 0 {
+		t.Errorf("OrElse() = %v, want %v", got.Unwrap(), 0)
+	}
+}
+
+func Test_MapResult(t *testing.T) {
+	got := MapResult(Ok(10), func(value int) int { return value * value })
+	if got.Unwrap() != 100 {
+		t.Errorf("MapResult() = %v, want %v", got.Unwrap(), 100)
+	}
+	failed := FlatMapResult(Ok("abc"), func(value string) Result[int] {
+		return ResultOf(strconv.Atoi(value))
+	})
+	if failed.IsOk() {
+		t.Errorf("FlatMapResult() = %v, want an error", failed.Unwrap())
+	}
+}
+
+func Test_FindOpt(t *testing.T) {
+	found := FindOpt([]interface{}{1, nil, 3}, func(i int, item interface{}) bool {
+		return item == nil
+	})
+	if !found.IsSome() || found.Unwrap() != nil {
+		t.Errorf("FindOpt() = %v, want %v", found.IsSome(), true)
+	}
+	missing := FindOpt([]interface{}{1, 2, 3}, func(i int, item interface{}) bool {
+		return item == nil
+	})
+	if missing.IsSome() {
+		t.Errorf("FindOpt() = %v, want %v", missing.IsSome(), false)
+	}
+}
+
+func Test_HeadOpt(t *testing.T) {
+	if !HeadOpt([]interface{}{nil, 2}).IsSome() || HeadOpt([]interface{}{}).IsSome() {
+		t.Errorf("HeadOpt() = %v, want %v", HeadOpt([]interface{}{}).IsSome(), false)
+	}
+}
+
+func Test_TailOpt(t *testing.T) {
+	if TailOpt([]interface{}{1, 2}).Unwrap() != 2 || TailOpt([]interface{}{}).IsSome() {
+		t.Errorf("TailOpt() = %v, want %v", TailOpt([]interface{}{}).IsSome(), false)
+	}
+}
+
+func Test_GetOpt(t *testing.T) {
+	data := map[string]interface{}{
+		"contacts": map[string]interface{}{
+			"fax": nil,
+		},
+	}
+	fax := GetOpt(data, "contacts.fax")
+	if !fax.IsSome() || fax.Unwrap() != nil {
+		t.Errorf("GetOpt() = %v, want %v", fax.IsSome(), true)
+	}
+	email := GetOpt(data, "contacts.email")
+	if email.IsSome() {
+		t.Errorf("GetOpt() = %v, want %v", email.IsSome(), false)
+	}
+}
@@ -0,0 +1,265 @@
+package gofp
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+type parallelConfig struct {
+	workers   int
+	unordered bool
+}
+
+// ParallelOption configures ParallelMap, ParallelFilter, ParallelForEach and ParallelReduce
+type ParallelOption func(*parallelConfig)
+
+// WithWorkers sets the maximum number of goroutines processing the items, it defaults to GOMAXPROCS
+func WithWorkers(workers int) ParallelOption {
+	return func(config *parallelConfig) {
+		if workers > 0 {
+			config.workers = workers
+		}
+	}
+}
+
+// WithUnordered makes the results come out in the order they complete instead of the order of the items
+func WithUnordered() ParallelOption {
+	return func(config *parallelConfig) {
+		config.unordered = true
+	}
+}
+
+func newParallelConfig(opts []ParallelOption) parallelConfig {
+	config := parallelConfig{workers: runtime.GOMAXPROCS(0)}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return config
+}
+
+// ElementError is the failure of the function for the item at Index
+type ElementError struct {
+	Index int
+	Err   error
+}
+
+func (e *ElementError) Error() string {
+	return fmt.Sprintf("item %d failed: %v", e.Index, e.Err)
+}
+
+func (e *ElementError) Unwrap() error {
+	return e.Err
+}
+
+// ElementErrors holds every ElementError of a parallel operation ordered by index
+type ElementErrors []*ElementError
+
+func (e ElementErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap allows errors.Is and errors.As to match any of the element errors
+func (e ElementErrors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+	return errs
+}
+
+// runParallel calls fn for every index from a pool of workers. It stops handing out indexes once the
+// context is done and returns the failures ordered by index
+func runParallel(ctx context.Context, length int, workers int, fn func(index int) error) error {
+	if workers > length {
+		workers = length
+	}
+	indexes := make(chan int)
+	failures := make([]error, length)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				failures[index] = fn(index)
+			}
+		}()
+	}
+dispatch:
+	for index := 0; index < length; index++ {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case indexes <- index:
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var errs ElementErrors
+	for index, err := range failures {
+		if err != nil {
+			errs = append(errs, &ElementError{Index: index, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// collector gathers the results of a parallel operation either by index or by completion order
+type collector[T any] struct {
+	unordered bool
+	mu        sync.Mutex
+	values    []T
+	present   []bool
+}
+
+func newCollector[T any](length int, unordered bool) *collector[T] {
+	if unordered {
+		return &collector[T]{unordered: true, values: make([]T, 0, length)}
+	}
+	return &collector[T]{values: make([]T, length), present: make([]bool, length)}
+}
+
+func (c *collector[T]) add(index int, value T) {
+	if !c.unordered {
+		c.values[index] = value
+		c.present[index] = true
+		return
+	}
+	c.mu.Lock()
+	c.values = append(c.values, value)
+	c.mu.Unlock()
+}
+
+func (c *collector[T]) result() []T {
+	if c.unordered {
+		return c.values
+	}
+	items := make([]T, 0, len(c.values))
+	for index, value := range c.values {
+		if c.present[index] {
+			items = append(items, value)
+		}
+	}
+	return items
+}
+
+// splitEvery splits the items into consecutive parts of at most size items
+func splitEvery[T any](items []T, size int) [][]T {
+	parts := [][]T{}
+	for startAt := 0; startAt < len(items); startAt += size {
+		upperLimit := startAt + size
+		if upperLimit > len(items) {
+			upperLimit = len(items)
+		}
+		parts = append(parts, items[startAt:upperLimit])
+	}
+	return parts
+}
+
+// ParallelMap returns a new slice with transformed elements processing the items concurrently.
+// Items for which fn fails are left out of the result and reported through ElementErrors. If the
+// context is done the results processed so far are returned together with the context error
+func ParallelMap[T, U any](ctx context.Context, items []T, fn func(ctx context.Context, index int, item T) (U, error), opts ...ParallelOption) ([]U, error) {
+	config := newParallelConfig(opts)
+	results := newCollector[U](len(items), config.unordered)
+	err := runParallel(ctx, len(items), config.workers, func(index int) error {
+		value, err := fn(ctx, index, items[index])
+		if err != nil {
+			return err
+		}
+		results.add(index, value)
+		return nil
+	})
+	return results.result(), err
+}
+
+// ParallelFilter returns a new slice of items which satisfies the condition processing the items concurrently.
+// Failures are reported the same way as ParallelMap
+func ParallelFilter[T any](ctx context.Context, items []T, fn func(ctx context.Context, index int, item T) (bool, error), opts ...ParallelOption) ([]T, error) {
+	config := newParallelConfig(opts)
+	results := newCollector[T](len(items), config.unordered)
+	err := runParallel(ctx, len(items), config.workers, func(index int) error {
+		keep, err := fn(ctx, index, items[index])
+		if err != nil {
+			return err
+		}
+		if keep {
+			results.add(index, items[index])
+		}
+		return nil
+	})
+	return results.result(), err
+}
+
+// ParallelForEach calls fn for every item concurrently and returns the failures as ElementErrors
+func ParallelForEach[T any](ctx context.Context, items []T, fn func(ctx context.Context, index int, item T) error, opts ...ParallelOption) error {
+	config := newParallelConfig(opts)
+	return runParallel(ctx, len(items), config.workers, func(index int) error {
+		return fn(ctx, index, items[index])
+	})
+}
+
+// ParallelReduce combines all the items with an associative function. The items are split into
+// one part per worker, every part is reduced concurrently and the partial results are combined
+// pairwise level by level, keeping the order of the items. It returns the zero value for an
+// empty slice. Failures are returned as ElementErrors once the current level has finished, the
+// Index of each is the index of the item passed as right, or of the first item a partial result
+// passed as right was reduced from
+func ParallelReduce[T any](ctx context.Context, items []T, fn func(ctx context.Context, left T, right T) (T, error), opts ...ParallelOption) (result T, err error) {
+	if len(items) == 0 {
+		return result, nil
+	}
+	config := newParallelConfig(opts)
+	size := (len(items) + config.workers - 1) / config.workers
+	level := splitEvery(items, size)
+	// firsts holds the index of the first item every value of the level was reduced from
+	firsts := make([]int, len(items))
+	for i := range firsts {
+		firsts[i] = i
+	}
+	for len(level) > 1 || len(level[0]) > 1 {
+		partials := make([]T, len(level))
+		failed := make([]int, len(level))
+		err := runParallel(ctx, len(level), config.workers, func(index int) error {
+			accumulator := level[index][0]
+			for i, item := range level[index][1:] {
+				value, err := fn(ctx, accumulator, item)
+				if err != nil {
+					failed[index] = firsts[index*size+i+1]
+					return err
+				}
+				accumulator = value
+			}
+			partials[index] = accumulator
+			return nil
+		})
+		if errs, ok := err.(ElementErrors); ok {
+			for _, elementErr := range errs {
+				elementErr.Index = failed[elementErr.Index]
+			}
+		}
+		if err != nil {
+			return result, err
+		}
+		for i := range partials {
+			firsts[i] = firsts[i*size]
+		}
+		firsts = firsts[:len(partials)]
+		level, size = splitEvery(partials, 2), 2
+	}
+	return level[0][0], nil
+}
@@ -0,0 +1,145 @@
+package gofp
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func Test_ParallelMap(t *testing.T) {
+	items := Range(1, 100)
+	got, err := ParallelMap(context.Background(), items, func(ctx context.Context, index int, item int) (int, error) {
+		return item * item, nil
+	}, WithWorkers(4))
+	want := []int{}
+	for _, item := range items {
+		want = append(want, item*item)
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelMap() = %v, %v, want %v, %v", got, err, want, nil)
+	}
+}
+
+func Test_ParallelMapMatchesMap(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5, 6, 7}
+	square := func(i int, item interface{}) interface{} {
+		return item.(int) * item.(int)
+	}
+	got, _ := ParallelMap(context.Background(), items, func(ctx context.Context, index int, item interface{}) (interface{}, error) {
+		return square(index, item), nil
+	})
+	if want := Map(items, square); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelMap() = %v, want %v", got, want)
+	}
+}
+
+func Test_ParallelMapUnordered(t *testing.T) {
+	got, err := ParallelMap(context.Background(), []int{3, 1, 2}, func(ctx context.Context, index int, item int) (int, error) {
+		time.Sleep(time.Duration(item) * time.Millisecond)
+		return item, nil
+	}, WithWorkers(3), WithUnordered())
+	sort.Ints(got)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("ParallelMap() = %v, %v, want %v, %v", got, err, []int{1, 2, 3}, nil)
+	}
+}
+
+func Test_ParallelMapErrors(t *testing.T) {
+	errOdd := errors.New("odd")
+	got, err := ParallelMap(context.Background(), []int{1, 2, 3, 4}, func(ctx context.Context, index int, item int) (int, error) {
+		if item%2 == 1 {
+			return 0, errOdd
+		}
+		return item, nil
+	})
+	var errs ElementErrors
+	if !errors.As(err, &errs) || len(errs) != 2 || errs[0].Index != 0 || errs[1].Index != 2 || !errors.Is(err, errOdd) {
+		t.Errorf("ParallelMap() error = %v, want failures at %v and %v", err, 0, 2)
+	}
+	if !reflect.DeepEqual(got, []int{2, 4}) {
+		t.Errorf("ParallelMap() = %v, want %v", got, []int{2, 4})
+	}
+}
+
+func Test_ParallelMapCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	_, err := ParallelMap(ctx, Range(1, 1000), func(ctx context.Context, index int, item int) (int, error) {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			cancel()
+		}
+		return item, nil
+	}, WithWorkers(2))
+	if !errors.Is(err, context.Canceled) || atomic.LoadInt32(&calls) >= 1000 {
+		t.Errorf("ParallelMap() error = %v after %v calls, want %v", err, calls, context.Canceled)
+	}
+}
+
+func Test_ParallelFilter(t *testing.T) {
+	got, err := ParallelFilter(context.Background(), []int{12, 16, 18, 20, 23, 40, 25}, func(ctx context.Context, index int, age int) (bool, error) {
+		return age >= 20, nil
+	}, WithWorkers(3))
+	want := []int{20, 23, 40, 25}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParallelFilter() = %v, %v, want %v, %v", got, err, want, nil)
+	}
+}
+
+func Test_ParallelForEach(t *testing.T) {
+	var total int64
+	err := ParallelForEach(context.Background(), Range(1, 100), func(ctx context.Context, index int, item int) error {
+		atomic.AddInt64(&total, int64(item))
+		return nil
+	})
+	if err != nil || total != 5050 {
+		t.Errorf("ParallelForEach() = %v, %v, want %v, %v", total, err, 5050, nil)
+	}
+}
+
+func Test_ParallelReduce(t *testing.T) {
+	words := []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j"}
+	for _, workers := range []int{1, 3, 4, 16} {
+		got, err := ParallelReduce(context.Background(), words, func(ctx context.Context, left string, right string) (string, error) {
+			return left + right, nil
+		}, WithWorkers(workers))
+		if err != nil || got != "abcdefghij" {
+			t.Errorf("ParallelReduce() with %v workers = %v, %v, want %v, %v", workers, got, err, "abcdefghij", nil)
+		}
+	}
+	empty, err := ParallelReduce(context.Background(), []int{}, func(ctx context.Context, left int, right int) (int, error) {
+		return left + right, nil
+	})
+	if err != nil || empty != 0 {
+		t.Errorf("ParallelReduce() = %v, %v, want %v, %v", empty, err, 0, nil)
+	}
+}
+
+func Test_ParallelReduceErrors(t *testing.T) {
+	failure := errors.New("failed")
+	items := []int{0, 1, 2, 3, 4, 5, 6, 7}
+	tests := []struct {
+		fail int
+		want int
+	}{
+		{5, 5},
+		{7, 7},
+		{13, 6},
+		{22, 4},
+	}
+	for _, tt := range tests {
+		_, err := ParallelReduce(context.Background(), items, func(ctx context.Context, left int, right int) (int, error) {
+			if right == tt.fail {
+				return 0, failure
+			}
+			return left + right, nil
+		}, WithWorkers(4))
+		var errs ElementErrors
+		if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Index != tt.want || !errors.Is(err, failure) {
+			t.Errorf("ParallelReduce() = %v, want the failure of item %v", err, tt.want)
+		}
+	}
+}
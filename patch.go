@@ -0,0 +1,476 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Operation names of RFC 6902 JSON Patch
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+	OpMove    = "move"
+	OpCopy    = "copy"
+	OpTest    = "test"
+)
+
+// PatchOperation is a single RFC 6902 JSON Patch operation. Path and From are JSON Pointers such as /contacts/emails/0
+type PatchOperation struct {
+	Op    string
+	Path  string
+	From  string
+	Value interface{}
+}
+
+// Patch is a list of operations which is serialized as an RFC 6902 JSON Patch document
+type Patch []PatchOperation
+
+// MarshalJSON writes the operation with the members required by its op
+func (o PatchOperation) MarshalJSON() ([]byte, error) {
+	operation := map[string]interface{}{"op": o.Op, "path": o.Path}
+	switch o.Op {
+	case OpAdd, OpReplace, OpTest:
+		operation["value"] = o.Value
+	case OpMove, OpCopy:
+		operation["from"] = o.From
+	}
+	return json.Marshal(operation)
+}
+
+// UnmarshalJSON reads an operation and checks that the members required by its op are present
+func (o *PatchOperation) UnmarshalJSON(data []byte) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+	operation := PatchOperation{}
+	if err := unmarshalMember(members, "op", &operation.Op); err != nil {
+		return err
+	}
+	if err := unmarshalMember(members, "path", &operation.Path); err != nil {
+		return fmt.Errorf("%w in %q operation", err, operation.Op)
+	}
+	var err error
+	switch operation.Op {
+	case OpAdd, OpReplace, OpTest:
+		err = unmarshalMember(members, "value", &operation.Value)
+	case OpMove, OpCopy:
+		err = unmarshalMember(members, "from", &operation.From)
+	case OpRemove:
+	default:
+		return fmt.Errorf("unknown operation %q", operation.Op)
+	}
+	if err != nil {
+		return fmt.Errorf("%w in %q operation", err, operation.Op)
+	}
+	*o = operation
+	return nil
+}
+
+func unmarshalMember(members map[string]json.RawMessage, name string, target interface{}) error {
+	raw, exists := members[name]
+	if !exists {
+		return fmt.Errorf("missing %s", name)
+	}
+	return json.Unmarshal(raw, target)
+}
+
+// ParsePatch reads an RFC 6902 JSON Patch document
+func ParsePatch(data []byte) (Patch, error) {
+	patch := Patch{}
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// Diff returns the operations which turn the map a into the map b. Nested maps and slices are
+// compared recursively, slices element by element
+func Diff(a map[string]interface{}, b map[string]interface{}) Patch {
+	patch := Patch{}
+	diffMaps("", a, b, &patch)
+	return patch
+}
+
+func diffMaps(pointer string, a map[string]interface{}, b map[string]interface{}, patch *Patch) {
+	for _, key := range sortedKeys(a) {
+		if _, exists := b[key]; !exists {
+			*patch = append(*patch, PatchOperation{Op: OpRemove, Path: pointer + "/" + escapePointer(key)})
+		}
+	}
+	for _, key := range sortedKeys(b) {
+		path := pointer + "/" + escapePointer(key)
+		if value, exists := a[key]; exists {
+			diffValues(path, value, b[key], patch)
+		} else {
+			*patch = append(*patch, PatchOperation{Op: OpAdd, Path: path, Value: b[key]})
+		}
+	}
+}
+
+func diffValues(pointer string, a interface{}, b interface{}, patch *Patch) {
+	if aMap, ok := asStringMap(a); ok {
+		if bMap, ok := asStringMap(b); ok {
+			diffMaps(pointer, aMap, bMap, patch)
+			return
+		}
+	}
+	aSlice, aIsSlice := sliceValue(a)
+	bSlice, bIsSlice := sliceValue(b)
+	if aIsSlice && bIsSlice {
+		common := aSlice.Len()
+		if bSlice.Len() < common {
+			common = bSlice.Len()
+		}
+		for i := 0; i < common; i++ {
+			diffValues(pointer+"/"+strconv.Itoa(i), aSlice.Index(i).Interface(), bSlice.Index(i).Interface(), patch)
+		}
+		for i := aSlice.Len() - 1; i >= common; i-- {
+			*patch = append(*patch, PatchOperation{Op: OpRemove, Path: pointer + "/" + strconv.Itoa(i)})
+		}
+		for i := common; i < bSlice.Len(); i++ {
+			*patch = append(*patch, PatchOperation{Op: OpAdd, Path: pointer + "/" + strconv.Itoa(i), Value: bSlice.Index(i).Interface()})
+		}
+		return
+	}
+	if !jsonEqual(a, b) {
+		*patch = append(*patch, PatchOperation{Op: OpReplace, Path: pointer, Value: b})
+	}
+}
+
+// Apply returns a new map with the patch applied to doc, doc itself isn't modified. The operations
+// are applied in order and the first failing operation aborts the whole patch
+func Apply(doc map[string]interface{}, patch Patch) (map[string]interface{}, error) {
+	var result interface{} = deepCopy(doc)
+	for i, operation := range patch {
+		var err error
+		result, err = applyOperation(result, operation)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d (%s %s): %w", i, operation.Op, operation.Path, err)
+		}
+	}
+	mapData, ok := result.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("patch result is not a map")
+	}
+	return mapData, nil
+}
+
+func applyOperation(doc interface{}, operation PatchOperation) (interface{}, error) {
+	tokens, err := parsePointer(operation.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch operation.Op {
+	case OpAdd:
+		return addAt(doc, tokens, deepCopy(operation.Value))
+	case OpRemove:
+		result, _, err := removeAt(doc, tokens)
+		return result, err
+	case OpReplace:
+		if _, err := valueAt(doc, tokens); err != nil {
+			return nil, err
+		}
+		if len(tokens) == 0 {
+			return deepCopy(operation.Value), nil
+		}
+		result, _, err := removeAt(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		return addAt(result, tokens, deepCopy(operation.Value))
+	case OpMove, OpCopy:
+		from, err := parsePointer(operation.From)
+		if err != nil {
+			return nil, err
+		}
+		if operation.Op == OpMove {
+			if operation.Path != operation.From && strings.HasPrefix(operation.Path, operation.From+"/") {
+				return nil, errors.New("can't move a value into one of its children")
+			}
+			result, value, err := removeAt(doc, from)
+			if err != nil {
+				return nil, err
+			}
+			return addAt(result, tokens, value)
+		}
+		value, err := valueAt(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return addAt(doc, tokens, deepCopy(value))
+	case OpTest:
+		value, err := valueAt(doc, tokens)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonEqual(value, operation.Value) {
+			return nil, errors.New("test failed")
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("unknown operation %q", operation.Op)
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its reference tokens
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("invalid JSON Pointer %q", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+func escapePointer(key string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func arrayIndex(token string, length int, allowEnd bool) (int, error) {
+	if allowEnd && token == "-" {
+		return length, nil
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if index > length || (!allowEnd && index == length) {
+		return 0, fmt.Errorf("array index %d out of range", index)
+	}
+	return index, nil
+}
+
+func valueAt(doc interface{}, tokens []string) (interface{}, error) {
+	for _, token := range tokens {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			value, exists := node[token]
+			if !exists {
+				return nil, fmt.Errorf("key %q doesn't exist", token)
+			}
+			doc = value
+		case []interface{}:
+			index, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[index]
+		default:
+			return nil, fmt.Errorf("can't look up %q in a %T", token, doc)
+		}
+	}
+	return doc, nil
+}
+
+// addAt adds value at the location, inserting into arrays, and returns the updated document
+func addAt(doc interface{}, tokens []string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	token := tokens[0]
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		if len(tokens) == 1 {
+			node[token] = value
+			return node, nil
+		}
+		child, exists := node[token]
+		if !exists {
+			return nil, fmt.Errorf("key %q doesn't exist", token)
+		}
+		updated, err := addAt(child, tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		node[token] = updated
+		return node, nil
+	case []interface{}:
+		index, err := arrayIndex(token, len(node), len(tokens) == 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == 1 {
+			node = append(node, nil)
+			copy(node[index+1:], node[index:])
+			node[index] = value
+			return node, nil
+		}
+		updated, err := addAt(node[index], tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		node[index] = updated
+		return node, nil
+	}
+	return nil, fmt.Errorf("can't add %q to a %T", token, doc)
+}
+
+// removeAt removes the value at the location and returns the updated document and the removed value
+func removeAt(doc interface{}, tokens []string) (interface{}, interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, nil, errors.New("can't remove the whole document")
+	}
+	token := tokens[0]
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		child, exists := node[token]
+		if !exists {
+			return nil, nil, fmt.Errorf("key %q doesn't exist", token)
+		}
+		if len(tokens) == 1 {
+			delete(node, token)
+			return node, child, nil
+		}
+		updated, removed, err := removeAt(child, tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		node[token] = updated
+		return node, removed, nil
+	case []interface{}:
+		index, err := arrayIndex(token, len(node), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(tokens) == 1 {
+			removed := node[index]
+			return append(node[:index], node[index+1:]...), removed, nil
+		}
+		updated, removed, err := removeAt(node[index], tokens[1:])
+		if err != nil {
+			return nil, nil, err
+		}
+		node[index] = updated
+		return node, removed, nil
+	}
+	return nil, nil, fmt.Errorf("can't remove %q from a %T", token, doc)
+}
+
+// deepCopy copies nested maps with string keys and slices as map[string]interface{} and []interface{}
+func deepCopy(data interface{}) interface{} {
+	if mapData, ok := asStringMap(data); ok {
+		newMap := make(map[string]interface{}, len(mapData))
+		for key, value := range mapData {
+			newMap[key] = deepCopy(value)
+		}
+		return newMap
+	}
+	if slice, ok := sliceValue(data); ok {
+		items := make([]interface{}, slice.Len())
+		for i := range items {
+			items[i] = deepCopy(slice.Index(i).Interface())
+		}
+		return items
+	}
+	return data
+}
+
+// jsonEqual compares values the way JSON does, numbers of any type are equal if their values are
+func jsonEqual(a interface{}, b interface{}) bool {
+	if aMap, ok := asStringMap(a); ok {
+		bMap, ok := asStringMap(b)
+		if !ok || len(aMap) != len(bMap) {
+			return false
+		}
+		for key, value := range aMap {
+			other, exists := bMap[key]
+			if !exists || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	}
+	if aSlice, ok := sliceValue(a); ok {
+		bSlice, ok := sliceValue(b)
+		if !ok || aSlice.Len() != bSlice.Len() {
+			return false
+		}
+		for i := 0; i < aSlice.Len(); i++ {
+			if !jsonEqual(aSlice.Index(i).Interface(), bSlice.Index(i).Interface()) {
+				return false
+			}
+		}
+		return true
+	}
+	if x, ok := toFloat64(a); ok {
+		y, isNumber := toFloat64(b)
+		return isNumber && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat64(data interface{}) (float64, bool) {
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(value.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), true
+	}
+	return 0, false
+}
+
+func sortedKeys(mapData map[string]interface{}) []string {
+	keys := Keys(mapData)
+	sort.Strings(keys)
+	return keys
+}
+
+// MergePatch returns a new map with the RFC 7386 JSON Merge Patch applied to doc. A nil value in
+// the patch removes the key, nested maps are merged and any other value replaces the current one
+func MergePatch(doc map[string]interface{}, patch map[string]interface{}) map[string]interface{} {
+	newMap := make(map[string]interface{}, len(doc))
+	for key, value := range doc {
+		newMap[key] = value
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(newMap, key)
+			continue
+		}
+		if patchMap, ok := asStringMap(value); ok {
+			current, _ := asStringMap(newMap[key])
+			newMap[key] = MergePatch(current, patchMap)
+			continue
+		}
+		newMap[key] = value
+	}
+	return newMap
+}
+
+// CreateMergePatch returns the RFC 7386 JSON Merge Patch which turns the map a into the map b
+func CreateMergePatch(a map[string]interface{}, b map[string]interface{}) map[string]interface{} {
+	patch := map[string]interface{}{}
+	for key := range a {
+		if _, exists := b[key]; !exists {
+			patch[key] = nil
+		}
+	}
+	for key, value := range b {
+		current, exists := a[key]
+		currentMap, currentIsMap := asStringMap(current)
+		valueMap, valueIsMap := asStringMap(value)
+		switch {
+		case exists && currentIsMap && valueIsMap:
+			if nested := CreateMergePatch(currentMap, valueMap); len(nested) > 0 {
+				patch[key] = nested
+			}
+		case !exists || !jsonEqual(current, value):
+			patch[key] = value
+		}
+	}
+	return patch
+}
@@ -0,0 +1,151 @@
+package gofp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func Test_Diff(t *testing.T) {
+	a := map[string]interface{}{
+		"name": "John",
+		"age":  30,
+		"contacts": map[string]interface{}{
+			"emails": []interface{}{"a@gmail.com", "b@gmail.com"},
+			"fax":    "+44-208-1234567",
+		},
+	}
+	b := map[string]interface{}{
+		"name": "John",
+		"age":  31.0,
+		"male": true,
+		"contacts": map[string]interface{}{
+			"emails": []interface{}{"a@gmail.com"},
+			"a/b~c":  1,
+		},
+	}
+	got := Diff(a, b)
+	want := Patch{
+		{Op: OpReplace, Path: "/age", Value: 31.0},
+		{Op: OpRemove, Path: "/contacts/fax"},
+		{Op: OpAdd, Path: "/contacts/a~1b~0c", Value: 1},
+		{Op: OpRemove, Path: "/contacts/emails/1"},
+		{Op: OpAdd, Path: "/male", Value: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+	patched, err := Apply(a, got)
+	if err != nil || !jsonEqual(patched, b) {
+		t.Errorf("Apply(Diff()) = %v, %v, want %v", patched, err, b)
+	}
+	if len(Diff(a, a)) != 0 {
+		t.Errorf("Diff() = %v, want no operations", Diff(a, a))
+	}
+}
+
+func Test_Apply(t *testing.T) {
+	doc := map[string]interface{}{
+		"foo": []interface{}{"bar", "baz"},
+		"qux": map[string]interface{}{"quux": 1},
+	}
+	patch := Patch{
+		{Op: OpTest, Path: "/qux/quux", Value: 1.0},
+		{Op: OpAdd, Path: "/foo/1", Value: "qux"},
+		{Op: OpAdd, Path: "/foo/-", Value: "end"},
+		{Op: OpCopy, From: "/foo/0", Path: "/first"},
+		{Op: OpMove, From: "/qux/quux", Path: "/quux"},
+		{Op: OpReplace, Path: "/first", Value: "BAR"},
+		{Op: OpRemove, Path: "/foo/2"},
+	}
+	got, err := Apply(doc, patch)
+	want := map[string]interface{}{
+		"foo":   []interface{}{"bar", "qux", "end"},
+		"qux":   map[string]interface{}{},
+		"quux":  1,
+		"first": "BAR",
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("Apply() = %v, %v, want %v", got, err, want)
+	}
+	if len(doc["foo"].([]interface{})) != 2 || !Has(doc["qux"].(map[string]interface{}), "quux") {
+		t.Errorf("Apply() modified the original document: %v", doc)
+	}
+
+	failing := []Patch{
+		{{Op: OpTest, Path: "/qux/quux", Value: 2}},
+		{{Op: OpRemove, Path: "/missing"}},
+		{{Op: OpAdd, Path: "/foo/5", Value: 1}},
+		{{Op: OpReplace, Path: "/foo/01", Value: 1}},
+		{{Op: OpMove, From: "/qux", Path: "/qux/child"}},
+		{{Op: OpAdd, Path: "foo", Value: 1}},
+	}
+	for _, patch := range failing {
+		if _, err := Apply(doc, patch); err == nil {
+			t.Errorf("Apply(%v) error = %v, want an error", patch, err)
+		}
+	}
+}
+
+func Test_ParsePatch(t *testing.T) {
+	data := `[
+		{"op": "add", "path": "/a", "value": null},
+		{"op": "remove", "path": "/b"},
+		{"op": "copy", "from": "/c", "path": "/d"}
+	]`
+	patch, err := ParsePatch([]byte(data))
+	want := Patch{
+		{Op: OpAdd, Path: "/a"},
+		{Op: OpRemove, Path: "/b"},
+		{Op: OpCopy, From: "/c", Path: "/d"},
+	}
+	if err != nil || !reflect.DeepEqual(patch, want) {
+		t.Errorf("ParsePatch() = %v, %v, want %v", patch, err, want)
+	}
+	encoded, err := json.Marshal(patch)
+	if err != nil || string(encoded) != `[{"op":"add","path":"/a","value":null},{"op":"remove","path":"/b"},{"from":"/c","op":"copy","path":"/d"}]` {
+		t.Errorf("json.Marshal(Patch) = %s, %v", encoded, err)
+	}
+	for _, invalid := range []string{`[{"op": "add", "path": "/a"}]`, `[{"op": "move", "path": "/a"}]`, `[{"op": "jump", "path": "/a"}]`, `[{"op": "remove"}]`} {
+		if _, err := ParsePatch([]byte(invalid)); err == nil {
+			t.Errorf("ParsePatch(%s) error = %v, want an error", invalid, err)
+		}
+	}
+}
+
+func Test_MergePatch(t *testing.T) {
+	doc := map[string]interface{}{
+		"title":  "Goodbye!",
+		"author": map[string]interface{}{"givenName": "John", "familyName": "Doe"},
+		"tags":   []interface{}{"example", "sample"},
+	}
+	patch := map[string]interface{}{
+		"title":       "Hello!",
+		"phoneNumber": "+01-123-456-7890",
+		"author":      map[string]interface{}{"familyName": nil},
+		"tags":        []interface{}{"example"},
+	}
+	got := MergePatch(doc, patch)
+	want := map[string]interface{}{
+		"title":       "Hello!",
+		"author":      map[string]interface{}{"givenName": "John"},
+		"tags":        []interface{}{"example"},
+		"phoneNumber": "+01-123-456-7890",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergePatch() = %v, want %v", got, want)
+	}
+}
+
+func Test_CreateMergePatch(t *testing.T) {
+	a := map[string]interface{}{"a": "b", "c": map[string]interface{}{"d": "e", "f": "g"}, "h": 1}
+	b := map[string]interface{}{"a": "z", "c": map[string]interface{}{"d": "e"}, "h": 1}
+	got := CreateMergePatch(a, b)
+	want := map[string]interface{}{"a": "z", "c": map[string]interface{}{"f": nil}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CreateMergePatch() = %v, want %v", got, want)
+	}
+	if patched := MergePatch(a, got); !reflect.DeepEqual(patched, b) {
+		t.Errorf("MergePatch(CreateMergePatch()) = %v, want %v", patched, b)
+	}
+}
@@ -0,0 +1,360 @@
+package gofp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/rbrahul/gofp/internal/structs"
+)
+
+type segmentKind int
+
+const (
+	segmentKey segmentKind = iota
+	// segmentWildcard matches every child of a map, slice or struct
+	segmentWildcard
+	// segmentRecursive matches the value itself and all of its descendants
+	segmentRecursive
+)
+
+// pathSegment is a single step of a path, key is a map key, struct field or slice index.
+// indexed is true for unquoted brackets such as [0] and quoted for brackets such as ["a.b"]
+type pathSegment struct {
+	key     string
+	kind    segmentKind
+	indexed bool
+	quoted  bool
+}
+
+// PathMatch is a value found by GetAll together with the concrete path leading to it
+type PathMatch struct {
+	Path  string
+	Value interface{}
+}
+
+var (
+	// ErrKeyNotFound is reported when a map has no such key or a struct no such exported field
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrIndexOutOfRange is reported when an index is beyond the end of a slice, array or string
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrInvalidIndex is reported when a segment applied to a slice, array or string isn't a number
+	ErrInvalidIndex = errors.New("invalid index")
+	// ErrWildcard is reported for wildcards in a path which must lead to a single value
+	ErrWildcard = errors.New("wildcards are only supported by GetAll")
+)
+
+// PathError reports why a path couldn't be resolved. Segment is the key at which resolution
+// stopped and Index its position in the path, Index is -1 if the path itself is invalid
+type PathError struct {
+	Path    string
+	Segment string
+	Index   int
+	Err     error
+}
+
+func (e *PathError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("invalid path %q: %v", e.Path, e.Err)
+	}
+	return fmt.Sprintf("path %q: segment %d %q: %v", e.Path, e.Index, e.Segment, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// TypeMismatchError reports a value whose type doesn't allow the requested operation
+type TypeMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+func (e *TypeMismatchError) Error() string {
+	return fmt.Sprintf("expected %s, got %s", e.Expected, e.Actual)
+}
+
+// parsePath splits a path into segments. Segments are separated by dots, a backslash escapes the
+// next character and brackets hold either an index like [0] or [-1], a quoted key like ["a.b"]
+// or a wildcard. An unescaped * matches any child and ** matches any number of levels
+func parsePath(path string) ([]pathSegment, error) {
+	return splitPath(path, ".")
+}
+
+// splitPath works like parsePath with segments separated by separator instead of dots
+func splitPath(path string, separator string) ([]pathSegment, error) {
+	segments := []pathSegment{}
+	if path == "" {
+		return segments, nil
+	}
+	var (
+		key      strings.Builder
+		literal  bool
+		expected = true
+	)
+	pushKey := func(at int) error {
+		if key.Len() == 0 && !literal {
+			return fmt.Errorf("empty segment at position %d", at)
+		}
+		segment := pathSegment{key: key.String()}
+		if !literal && segment.key == "*" {
+			segment.kind = segmentWildcard
+		}
+		if !literal && segment.key == "**" {
+			segment.kind = segmentRecursive
+		}
+		segments = append(segments, segment)
+		key.Reset()
+		literal = false
+		expected = false
+		return nil
+	}
+	for i := 0; i < len(path); i++ {
+		if strings.HasPrefix(path[i:], separator) {
+			if expected || key.Len() > 0 || literal {
+				if err := pushKey(i); err != nil {
+					return nil, err
+				}
+			}
+			expected = true
+			i += len(separator) - 1
+			continue
+		}
+		switch c := path[i]; c {
+		case '\\':
+			if i+1 >= len(path) {
+				return nil, errors.New("trailing escape character")
+			}
+			i++
+			key.WriteByte(path[i])
+			literal = true
+		case '[':
+			if key.Len() > 0 || literal {
+				if err := pushKey(i); err != nil {
+					return nil, err
+				}
+			}
+			segment, end, err := parseBracket(path, i)
+			if err != nil {
+				return nil, err
+			}
+			segments = append(segments, segment)
+			expected = false
+			i = end
+			if i+1 < len(path) && path[i+1] != '[' && !strings.HasPrefix(path[i+1:], separator) {
+				return nil, fmt.Errorf("unexpected character %q at position %d", path[i+1], i+1)
+			}
+		default:
+			key.WriteByte(c)
+		}
+	}
+	if expected || key.Len() > 0 || literal {
+		if err := pushKey(len(path)); err != nil {
+			return nil, err
+		}
+	}
+	return segments, nil
+}
+
+// parseBracket parses the bracket starting at path[start] and returns the position of the closing bracket
+func parseBracket(path string, start int) (pathSegment, int, error) {
+	i := start + 1
+	if i < len(path) && (path[i] == '"' || path[i] == '\'') {
+		quote := path[i]
+		var key strings.Builder
+		for i++; i < len(path) && path[i] != quote; i++ {
+			if path[i] == '\\' && i+1 < len(path) {
+				i++
+			}
+			key.WriteByte(path[i])
+		}
+		if i+1 >= len(path) || path[i+1] != ']' {
+			return pathSegment{}, 0, fmt.Errorf("unterminated bracket at position %d", start)
+		}
+		return pathSegment{key: key.String(), quoted: true}, i + 1, nil
+	}
+	end := strings.IndexByte(path[i:], ']')
+	if end < 0 {
+		return pathSegment{}, 0, fmt.Errorf("unterminated bracket at position %d", start)
+	}
+	content := strings.TrimSpace(path[i : i+end])
+	switch content {
+	case "":
+		return pathSegment{}, 0, fmt.Errorf("empty bracket at position %d", start)
+	case "*":
+		return pathSegment{key: content, kind: segmentWildcard}, i + end, nil
+	case "**":
+		return pathSegment{key: content, kind: segmentRecursive}, i + end, nil
+	}
+	return pathSegment{key: content, indexed: true}, i + end, nil
+}
+
+// formatPath joins keys into a path which parsePath reads back to the same keys
+func formatPath(keys []string) string {
+	escaped := make([]string, len(keys))
+	for i, key := range keys {
+		escaped[i] = escapeKey(key, ".")
+	}
+	return strings.Join(escaped, ".")
+}
+
+// escapeKey escapes the characters of key which splitPath would otherwise treat as syntax
+func escapeKey(key string, separator string) string {
+	var path strings.Builder
+	if key == "*" || key == "**" {
+		path.WriteByte('\\')
+	}
+	for j := 0; j < len(key); j++ {
+		if strings.HasPrefix(key[j:], separator) || strings.IndexByte(`\[]`, key[j]) >= 0 {
+			path.WriteByte('\\')
+		}
+		path.WriteByte(key[j])
+	}
+	return path.String()
+}
+
+// resolveStrict works like resolveSegments but explains where and why resolution failed
+func resolveStrict(data interface{}, path string, segments []pathSegment) (interface{}, error) {
+	for index, segment := range segments {
+		if segment.kind != segmentKey {
+			return nil, &PathError{Path: path, Segment: segment.key, Index: index, Err: ErrWildcard}
+		}
+		value, _, found := lookupKey(data, segment.key)
+		if !found {
+			return nil, &PathError{Path: path, Segment: segment.key, Index: index, Err: lookupError(data, segment.key)}
+		}
+		data = value
+	}
+	return data, nil
+}
+
+// lookupError explains why lookupKey didn't find key in data
+func lookupError(data interface{}, key string) error {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return &TypeMismatchError{Expected: "map, slice, struct or string", Actual: "nil " + value.Type().String()}
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Invalid:
+		return &TypeMismatchError{Expected: "map, slice, struct or string", Actual: "nil"}
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return &TypeMismatchError{Expected: "map with string keys", Actual: value.Type().String()}
+		}
+		return ErrKeyNotFound
+	case reflect.Slice, reflect.Array, reflect.String:
+		if _, err := strconv.Atoi(key); err != nil {
+			return ErrInvalidIndex
+		}
+		return ErrIndexOutOfRange
+	case reflect.Struct:
+		return ErrKeyNotFound
+	}
+	return &TypeMismatchError{Expected: "map, slice, struct or string", Actual: value.Type().String()}
+}
+
+// sliceIndex converts key to an index of a slice of the given length, negative indexes count from the end
+func sliceIndex(key string, length int) (int, bool) {
+	index, err := strconv.Atoi(key)
+	if err != nil {
+		return 0, false
+	}
+	if index < 0 {
+		index += length
+	}
+	return index, index >= 0 && index < length
+}
+
+type child struct {
+	key   string
+	value interface{}
+}
+
+// children returns the entries of a map in key order, the elements of a slice or the exported fields of a
+// struct including the promoted ones
+func children(data interface{}) []child {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	entries := []child{}
+	switch value.Kind() {
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			entries = append(entries, child{key: iter.Key().String(), value: iter.Value().Interface()})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].key < entries[j].key
+		})
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			entries = append(entries, child{key: strconv.Itoa(i), value: value.Index(i).Interface()})
+		}
+	case reflect.Struct:
+		for _, field := range structs.Members(value) {
+			entries = append(entries, child{key: field.Name, value: field.Value.Interface()})
+		}
+	}
+	return entries
+}
+
+// GetAll returns every value matching the path together with its concrete path. Besides the syntax
+// understood by Get, the path may contain * to match any key or index and ** to match any depth
+func GetAll(data interface{}, path string) []PathMatch {
+	segments, err := parsePath(path)
+	if err != nil || data == nil {
+		return []PathMatch{}
+	}
+	// consecutive ** match the same depths as a single one
+	collapsed := []pathSegment{}
+	for i, segment := range segments {
+		if segment.kind != segmentRecursive || i == 0 || segments[i-1].kind != segmentRecursive {
+			collapsed = append(collapsed, segment)
+		}
+	}
+	matches := []PathMatch{}
+	// several ** can still reach the same value along different routes, like **.a.** for a.a
+	seen := map[string]bool{}
+	var walk func(data interface{}, segments []pathSegment, keys []string)
+	walk = func(data interface{}, segments []pathSegment, keys []string) {
+		if len(segments) == 0 {
+			path := formatPath(keys)
+			if !seen[path] {
+				seen[path] = true
+				matches = append(matches, PathMatch{Path: path, Value: data})
+			}
+			return
+		}
+		segment := segments[0]
+		switch segment.kind {
+		case segmentKey:
+			if value, key, found := lookupKey(data, segment.key); found {
+				walk(value, segments[1:], append(keys[:len(keys):len(keys)], key))
+			}
+		case segmentWildcard:
+			for _, entry := range children(data) {
+				walk(entry.value, segments[1:], append(keys[:len(keys):len(keys)], entry.key))
+			}
+		case segmentRecursive:
+			walk(data, segments[1:], keys)
+			for _, entry := range children(data) {
+				walk(entry.value, segments, append(keys[:len(keys):len(keys)], entry.key))
+			}
+		}
+	}
+	walk(data, collapsed, []string{})
+	return matches
+}
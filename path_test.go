@@ -0,0 +1,140 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_parsePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want []pathSegment
+	}{
+		{"", []pathSegment{}},
+		{"a.b.0", []pathSegment{{key: "a"}, {key: "b"}, {key: "0"}}},
+		{`a\.b.c`, []pathSegment{{key: "a.b"}, {key: "c"}}},
+		{`items[0].name`, []pathSegment{{key: "items"}, {key: "0", indexed: true}, {key: "name"}}},
+		{`items[-1]`, []pathSegment{{key: "items"}, {key: "-1", indexed: true}}},
+		{`["a.b"]['c]d'].e`, []pathSegment{{key: "a.b", quoted: true}, {key: "c]d", quoted: true}, {key: "e"}}},
+		{`a.*.b[*]`, []pathSegment{{key: "a"}, {key: "*", kind: segmentWildcard}, {key: "b"}, {key: "*", kind: segmentWildcard}}},
+		{`a.**.c`, []pathSegment{{key: "a"}, {key: "**", kind: segmentRecursive}, {key: "c"}}},
+		{`a.\*.["**"]`, []pathSegment{{key: "a"}, {key: "*"}, {key: "**", quoted: true}}},
+	}
+	for _, tt := range tests {
+		got, err := parsePath(tt.path)
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parsePath(%q) = %v, %v, want %v", tt.path, got, err, tt.want)
+		}
+	}
+	for _, path := range []string{"a..b", ".a", "a.", `a\`, "a[0", `a["b]`, "a[]", "a[0]b"} {
+		if _, err := parsePath(path); err == nil {
+			t.Errorf("parsePath(%q) error = %v, want an error", path, err)
+		}
+	}
+}
+
+func Test_formatPath(t *testing.T) {
+	keys := []string{"a.b", "*", "items", "0", `c\d[e]`}
+	segments, err := parsePath(formatPath(keys))
+	if err != nil || len(segments) != len(keys) {
+		t.Fatalf("formatPath() = %v, want %v segments", formatPath(keys), len(keys))
+	}
+	for i, segment := range segments {
+		if segment.key != keys[i] || segment.kind != segmentKey {
+			t.Errorf("formatPath() segment %v = %v, want %v", i, segment.key, keys[i])
+		}
+	}
+}
+
+func Test_GetPathSyntax(t *testing.T) {
+	data := map[string]interface{}{
+		"example.com": map[string]interface{}{"port": 443},
+		"items":       []interface{}{map[string]interface{}{"id": 1}, map[string]interface{}{"id": 2}},
+	}
+	if Get(data, `example\.com.port`) != 443 || Get(data, `["example.com"].port`) != 443 {
+		t.Errorf("Get() got= %v, want %v", Get(data, `example\.com.port`), 443)
+	}
+	if Get(data, "items[-1].id") != 2 || Get(data, "items.-2.id") != 1 {
+		t.Errorf("Get() got= %v, want %v", Get(data, "items[-1].id"), 2)
+	}
+	if Get(data, "items.*.id", "n/a") != "n/a" {
+		t.Errorf("Get() got= %v, want %v", Get(data, "items.*.id", "n/a"), "n/a")
+	}
+}
+
+func Test_GetAll(t *testing.T) {
+	data := map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"id": 1, "tags": []string{"a"}},
+			map[string]interface{}{"id": 2, "child": map[string]interface{}{"id": 3}},
+		},
+		"owner": map[string]interface{}{"id": 4},
+	}
+	got := GetAll(data, "items[*].id")
+	want := []PathMatch{{Path: "items.0.id", Value: 1}, {Path: "items.1.id", Value: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAll() = %v, want %v", got, want)
+	}
+
+	got = GetAll(data, "**.id")
+	want = []PathMatch{
+		{Path: "items.0.id", Value: 1},
+		{Path: "items.1.id", Value: 2},
+		{Path: "items.1.child.id", Value: 3},
+		{Path: "owner.id", Value: 4},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAll() = %v, want %v", got, want)
+	}
+
+	got = GetAll(data, "items.-1.child.id")
+	want = []PathMatch{{Path: "items.1.child.id", Value: 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAll() = %v, want %v", got, want)
+	}
+	for _, match := range GetAll(data, "**") {
+		if Get(data, match.Path) == nil && match.Path != "" {
+			t.Errorf("GetAll() path %q can't be read back with Get()", match.Path)
+		}
+	}
+}
+
+func Test_GetAllRecursiveDuplicates(t *testing.T) {
+	data := map[string]interface{}{
+		"a": map[string]interface{}{
+			"a": map[string]interface{}{"b": 1},
+			"b": 2,
+		},
+	}
+	want := []PathMatch{{Path: "a.b", Value: 2}, {Path: "a.a.b", Value: 1}}
+	for _, path := range []string{"**.**.b", "**.a.**.b"} {
+		if got := GetAll(data, path); !reflect.DeepEqual(got, want) {
+			t.Errorf("GetAll(%q) = %v, want %v", path, got, want)
+		}
+	}
+}
+
+func Test_GetAllEmbeddedStructs(t *testing.T) {
+	type Inner struct {
+		X int
+	}
+	type Base struct {
+		ID int
+	}
+	type Outer struct {
+		Inner
+		*Base
+		Y int
+	}
+	data := Outer{Inner: Inner{X: 1}, Y: 2}
+	got := GetAll(data, "*")
+	want := []PathMatch{{Path: "Inner", Value: Inner{X: 1}}, {Path: "X", Value: 1}, {Path: "Y", Value: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetAll() = %v, want %v", got, want)
+	}
+	for _, match := range got {
+		if value := Get(data, match.Path); !reflect.DeepEqual(value, match.Value) {
+			t.Errorf("Get(%q) = %v, want %v", match.Path, value, match.Value)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package gofp
+
+import "reflect"
+
+// QueryOption configures a Query
+type QueryOption func(*Query)
+
+// WithFallback sets the value Get and Value return when the path can't be resolved
+func WithFallback(value interface{}) QueryOption {
+	return func(q *Query) {
+		q.fallback = value
+	}
+}
+
+// WithStrictTypes makes the typed methods of Query require a value of the exact type instead of coercing it
+func WithStrictTypes() QueryOption {
+	return func(q *Query) {
+		q.strict = true
+	}
+}
+
+// Query is a path parsed once, so it can be resolved against any number of values without parsing
+// it again. It understands the same syntax as Get and reports failures as a *PathError naming the
+// segment and the reason. A Query is safe for concurrent use
+type Query struct {
+	path     string
+	segments []pathSegment
+	fallback interface{}
+	strict   bool
+}
+
+// CompileQuery parses the path into a Query, the error is a *PathError if the path is invalid or has wildcards
+func CompileQuery(path string, opts ...QueryOption) (*Query, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, &PathError{Path: path, Index: -1, Err: err}
+	}
+	for index, segment := range segments {
+		if segment.kind != segmentKey {
+			return nil, &PathError{Path: path, Segment: segment.key, Index: index, Err: ErrWildcard}
+		}
+	}
+	q := &Query{path: path, segments: segments}
+	for _, opt := range opts {
+		opt(q)
+	}
+	return q, nil
+}
+
+// MustCompileQuery works like CompileQuery but panics if the path is invalid
+func MustCompileQuery(path string, opts ...QueryOption) *Query {
+	q, err := CompileQuery(path, opts...)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// String returns the path the Query was compiled from
+func (q *Query) String() string {
+	return q.path
+}
+
+// Get returns the value at the path. If it can't be resolved Get returns the fallback together with a *PathError
+func (q *Query) Get(data interface{}) (interface{}, error) {
+	value, err := resolveStrict(data, q.path, q.segments)
+	if err != nil {
+		return q.fallback, err
+	}
+	return value, nil
+}
+
+// Value returns the value at the path, or the fallback if it can't be resolved
+func (q *Query) Value(data interface{}) interface{} {
+	value, _ := q.Get(data)
+	return value
+}
+
+// Exists returns true if the path can be resolved, even if the value there is nil
+func (q *Query) Exists(data interface{}) bool {
+	_, err := resolveStrict(data, q.path, q.segments)
+	return err == nil
+}
+
+// GetString returns the value at the path as string, converted with CoerceString unless WithStrictTypes is set
+func (q *Query) GetString(data interface{}) (string, error) {
+	return queryAs(q, data, CoerceString)
+}
+
+// GetInt returns the value at the path as int, converted with CoerceInt unless WithStrictTypes is set
+func (q *Query) GetInt(data interface{}) (int, error) {
+	return queryAs(q, data, CoerceInt)
+}
+
+// GetFloat64 returns the value at the path as float64, converted with CoerceFloat64 unless WithStrictTypes is set
+func (q *Query) GetFloat64(data interface{}) (float64, error) {
+	return queryAs(q, data, CoerceFloat64)
+}
+
+// GetBool returns the value at the path as bool, converted with CoerceBool unless WithStrictTypes is set
+func (q *Query) GetBool(data interface{}) (bool, error) {
+	return queryAs(q, data, CoerceBool)
+}
+
+// GetSlice returns the slice or array at the path as a slice of interface. With WithStrictTypes it must be a []interface{}
+func (q *Query) GetSlice(data interface{}) ([]interface{}, error) {
+	return queryAs(q, data, toSlice)
+}
+
+// GetMap returns the map with string keys at the path as map[string]interface{}. With WithStrictTypes it must be a map[string]interface{}
+func (q *Query) GetMap(data interface{}) (map[string]interface{}, error) {
+	return queryAs(q, data, toMap)
+}
+
+// queryAs resolves the Query and converts the value, a conversion error is reported at the last segment
+func queryAs[T any](q *Query, data interface{}, coerce func(value interface{}) (T, error)) (T, error) {
+	var zero T
+	value, err := resolveStrict(data, q.path, q.segments)
+	if err != nil {
+		return zero, err
+	}
+	convert := coerce
+	if q.strict {
+		convert = exactType[T]
+	}
+	converted, err := convert(value)
+	if err != nil {
+		last := &PathError{Path: q.path, Index: len(q.segments) - 1, Err: err}
+		if len(q.segments) > 0 {
+			last.Segment = q.segments[len(q.segments)-1].key
+		}
+		return zero, last
+	}
+	return converted, nil
+}
+
+// exactType returns the value if it has the type T and a *TypeMismatchError otherwise
+func exactType[T any](value interface{}) (T, error) {
+	converted, ok := value.(T)
+	if !ok {
+		actual := "nil"
+		if value != nil {
+			actual = reflect.TypeOf(value).String()
+		}
+		return converted, &TypeMismatchError{Expected: reflect.TypeFor[T]().String(), Actual: actual}
+	}
+	return converted, nil
+}
@@ -0,0 +1,81 @@
+package gofp
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func Test_CompileQuery(t *testing.T) {
+	q, err := CompileQuery(`user["name"]`)
+	if err != nil || q.String() != `user["name"]` {
+		t.Errorf("CompileQuery() = %v, %v, want %v, %v", q, err, `user["name"]`, nil)
+	}
+	var pathErr *PathError
+	if _, err := CompileQuery("user..name"); !errors.As(err, &pathErr) || pathErr.Index != -1 {
+		t.Errorf("CompileQuery() error = %v, want an invalid path error", err)
+	}
+	if _, err := CompileQuery("items.*.id"); !errors.Is(err, ErrWildcard) {
+		t.Errorf("CompileQuery() error = %v, want %v", err, ErrWildcard)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustCompileQuery() with an invalid path didn't panic")
+		}
+	}()
+	MustCompileQuery("a[")
+}
+
+func Test_QueryGet(t *testing.T) {
+	q := MustCompileQuery("user.name", WithFallback("unknown"))
+	for _, data := range []interface{}{payload, map[string]interface{}{"user": map[string]string{"name": "Sofia"}}} {
+		if name, err := q.Get(data); err != nil || name != "Sofia" {
+			t.Errorf("Get() = %v, %v, want %v, %v", name, err, "Sofia", nil)
+		}
+	}
+	name, err := q.Get(map[string]interface{}{"user": 42})
+	var mismatch *TypeMismatchError
+	if name != "unknown" || !errors.As(err, &mismatch) || mismatch.Actual != "int" {
+		t.Errorf("Get() = %v, %v, want the fallback and a type mismatch", name, err)
+	}
+	if name := q.Value(nil); name != "unknown" {
+		t.Errorf("Value() = %v, want %v", name, "unknown")
+	}
+	if !q.Exists(payload) || q.Exists(map[string]interface{}{}) {
+		t.Errorf("Exists() = %v, want %v", q.Exists(map[string]interface{}{}), false)
+	}
+}
+
+func Test_QueryTyped(t *testing.T) {
+	age := MustCompileQuery("user.age")
+	if value, err := age.GetInt(payload); err != nil || value != 20 {
+		t.Errorf("GetInt() = %v, %v, want %v, %v", value, err, 20, nil)
+	}
+	strict := MustCompileQuery("user.age", WithStrictTypes())
+	_, err := strict.GetInt(payload)
+	var mismatch *TypeMismatchError
+	if !errors.As(err, &mismatch) || mismatch.Expected != "int" || mismatch.Actual != "float64" {
+		t.Errorf("GetInt() error = %v, want a mismatch between int and float64", err)
+	}
+	if want := `path "user.age": segment 1 "age": expected int, got float64`; err.Error() != want {
+		t.Errorf("GetInt() error = %q, want %q", err.Error(), want)
+	}
+	if value, err := strict.GetFloat64(payload); err != nil || value != 20 {
+		t.Errorf("GetFloat64() = %v, %v, want %v, %v", value, err, 20, nil)
+	}
+	if _, err := MustCompileQuery("user.tags", WithStrictTypes()).GetSlice(payload); err == nil {
+		t.Errorf("GetSlice() error = %v, want a mismatch for []string", err)
+	}
+	if tags, err := MustCompileQuery("user.tags").GetSlice(payload); err != nil || !reflect.DeepEqual(tags, []interface{}{"admin", "dev"}) {
+		t.Errorf("GetSlice() = %v, %v, want %v, %v", tags, err, []interface{}{"admin", "dev"}, nil)
+	}
+	if user, err := MustCompileQuery("user").GetMap(payload); err != nil || user["name"] != "Sofia" {
+		t.Errorf("GetMap() = %v, %v, want the user map", user, err)
+	}
+	if active, err := MustCompileQuery("user.active").GetBool(payload); err != nil || !active {
+		t.Errorf("GetBool() = %v, %v, want %v, %v", active, err, true, nil)
+	}
+	if name, err := MustCompileQuery("items[0].id").GetString(payload); err != nil || name != "1" {
+		t.Errorf("GetString() = %v, %v, want %v, %v", name, err, "1", nil)
+	}
+}
@@ -0,0 +1,46 @@
+package gofp
+
+import (
+	"github.com/rbrahul/gofp/generic"
+	"github.com/rbrahul/gofp/random"
+)
+
+// RandomSource produces the random numbers used by Shuffle, ChooseRandom, Sample and SampleWeighted.
+// It is the Source of the random package, which generic and sampling share
+type RandomSource = random.Source
+
+// RandomOption configures the RandomSource of the random functions
+type RandomOption = random.Option
+
+// WithSeed uses a source seeded with seed, so the same sequence of calls produces the same results.
+// The source is created once and shared by every call the option is passed to
+func WithSeed(seed int64) RandomOption {
+	return random.WithSeed(seed)
+}
+
+// WithSource uses the given RandomSource, a nil source is ignored
+func WithSource(source RandomSource) RandomOption {
+	return random.WithSource(source)
+}
+
+// CryptoSource returns a RandomSource backed by crypto/rand, which is unpredictable but slower
+func CryptoSource() RandomSource {
+	return random.CryptoSource()
+}
+
+// RandomSourceOf returns the RandomSource configured by the options. Without options it returns a
+// shared source which is seeded once and safe for concurrent use
+func RandomSourceOf(opts ...RandomOption) RandomSource {
+	return random.SourceOf(opts...)
+}
+
+// Sample returns a new slice of n items picked at random without replacement, all items if n exceeds the length
+func Sample(items []interface{}, n int, opts ...RandomOption) []interface{} {
+	return generic.Sample(items, n, opts...)
+}
+
+// SampleWeighted returns a new slice of up to n items picked at random without replacement. The chance of
+// an item is proportional to the weight returned from the function, items weighing 0 are never picked
+func SampleWeighted(items []interface{}, n int, fn func(index int, item interface{}) float64, opts ...RandomOption) []interface{} {
+	return generic.SampleWeighted(items, n, fn, opts...)
+}
@@ -0,0 +1,128 @@
+// Package random provides the sources of randomness shared by gofp, generic
+// and sampling. Every random function of those packages accepts Options, so
+// results can be made reproducible with WithSeed or driven by any Source.
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+	"math/big"
+	mathrand "math/rand"
+	randv2 "math/rand/v2"
+	"strconv"
+	"sync"
+)
+
+// Source produces random numbers. *rand.Rand of math/rand satisfies it, implementations must be
+// safe for concurrent use when shared
+type Source interface {
+	// Intn returns a uniformly distributed number in [0, n), it panics if n <= 0
+	Intn(n int) int
+	// Float64 returns a uniformly distributed number in [0, 1)
+	Float64() float64
+}
+
+// Option configures the Source of the random functions
+type Option func(*config)
+
+type config struct {
+	source Source
+}
+
+// WithSeed uses a source seeded with seed, so the same sequence of calls produces the same results.
+// The source is created once and shared by every call the option is passed to
+func WithSeed(seed int64) Option {
+	source := &lockedSource{random: mathrand.New(mathrand.NewSource(seed))}
+	return WithSource(source)
+}
+
+// WithSource uses the given Source, a nil source is ignored
+func WithSource(source Source) Option {
+	return func(c *config) {
+		if source != nil {
+			c.source = source
+		}
+	}
+}
+
+// CryptoSource returns a Source backed by crypto/rand, which is unpredictable but slower
+func CryptoSource() Source {
+	return cryptoSource{}
+}
+
+// SourceOf returns the Source configured by the options. Without options it returns a shared
+// source which is seeded once and safe for concurrent use
+func SourceOf(opts ...Option) Source {
+	c := config{source: defaultSource{}}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c.source
+}
+
+// defaultSource uses the goroutine-safe top level functions of math/rand/v2
+type defaultSource struct{}
+
+func (defaultSource) Intn(n int) int {
+	return randv2.IntN(n)
+}
+
+func (defaultSource) Float64() float64 {
+	return randv2.Float64()
+}
+
+// lockedSource guards a *rand.Rand, which isn't safe for concurrent use on its own
+type lockedSource struct {
+	mutex  sync.Mutex
+	random *mathrand.Rand
+}
+
+func (s *lockedSource) Intn(n int) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.random.Intn(n)
+}
+
+func (s *lockedSource) Float64() float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.random.Float64()
+}
+
+type cryptoSource struct{}
+
+func (cryptoSource) Intn(n int) int {
+	if n <= 0 {
+		panic("Invalid argument to Intn, n must be greater than 0")
+	}
+	number, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return int(number.Int64())
+}
+
+func (cryptoSource) Float64() float64 {
+	var buffer [8]byte
+	if _, err := rand.Read(buffer[:]); err != nil {
+		panic("crypto/rand failed: " + err.Error())
+	}
+	return float64(binary.BigEndian.Uint64(buffer[:])>>11) / (1 << 53)
+}
+
+// Weights returns the weight of every item returned from the function and their sum, it panics if a
+// weight is negative, NaN or infinite
+func Weights[T any](items []T, fn func(index int, item T) float64) ([]float64, float64) {
+	weights := make([]float64, len(items))
+	total := 0.0
+	for i, item := range items {
+		weight := fn(i, item)
+		if weight < 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			panic("Invalid weight " + strconv.FormatFloat(weight, 'g', -1, 64) + " at index " + strconv.Itoa(i) + ", weights must be finite and not negative")
+		}
+		weights[i] = weight
+		total += weight
+	}
+	return weights, total
+}
@@ -0,0 +1,55 @@
+package random
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func Test_WithSeed(t *testing.T) {
+	first, second := SourceOf(WithSeed(42)), SourceOf(WithSeed(42))
+	for i := 0; i < 10; i++ {
+		if a, b := first.Intn(100), second.Intn(100); a != b {
+			t.Errorf("Intn() with the same seed = %v and %v, want equal results", a, b)
+		}
+	}
+}
+
+func Test_WithSource(t *testing.T) {
+	source := rand.New(rand.NewSource(3))
+	if got := SourceOf(WithSource(source)); got != source {
+		t.Errorf("SourceOf() = %v, want %v", got, source)
+	}
+	if got := SourceOf(WithSource(nil)); got != (defaultSource{}) {
+		t.Errorf("SourceOf() = %v, want the default source", got)
+	}
+}
+
+func Test_CryptoSource(t *testing.T) {
+	source := CryptoSource()
+	for i := 0; i < 100; i++ {
+		if n := source.Intn(10); n < 0 || n >= 10 {
+			t.Errorf("Intn() = %v, want a number in [0, 10)", n)
+		}
+		if f := source.Float64(); f < 0 || f >= 1 {
+			t.Errorf("Float64() = %v, want a number in [0, 1)", f)
+		}
+	}
+}
+
+func Test_Weights(t *testing.T) {
+	weights, total := Weights([]string{"a", "bb", "ccc"}, func(_ int, item string) float64 {
+		return float64(len(item))
+	})
+	if !reflect.DeepEqual(weights, []float64{1, 2, 3}) || total != 6 {
+		t.Errorf("Weights() = %v, %v, want %v, %v", weights, total, []float64{1, 2, 3}, 6)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Weights() with a negative weight didn't panic")
+		}
+	}()
+	Weights([]int{1, -1}, func(_ int, item int) float64 {
+		return float64(item)
+	})
+}
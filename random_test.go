@@ -0,0 +1,116 @@
+package gofp
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func Test_WithSeed(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5, 6, 7, 8}
+	first := Shuffle(items, WithSeed(42))
+	second := Shuffle(items, WithSeed(42))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Shuffle() with the same seed = %v and %v, want equal results", first, second)
+	}
+	if ChooseRandom(items, WithSeed(7)) != ChooseRandom(items, WithSeed(7)) {
+		t.Errorf("ChooseRandom() with the same seed returned different items")
+	}
+}
+
+func Test_WithSource(t *testing.T) {
+	items := []interface{}{"a", "b", "c"}
+	first := Sample(items, 2, WithSource(rand.New(rand.NewSource(3))))
+	second := Sample(items, 2, WithSource(rand.New(rand.NewSource(3))))
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Sample() with equal sources = %v and %v, want equal results", first, second)
+	}
+	if source := RandomSourceOf(WithSource(nil)); source == nil {
+		t.Errorf("RandomSourceOf() = %v, want the default source", source)
+	}
+}
+
+func Test_CryptoSource(t *testing.T) {
+	source := CryptoSource()
+	for i := 0; i < 100; i++ {
+		if n := source.Intn(10); n < 0 || n >= 10 {
+			t.Errorf("Intn() = %v, want a number in [0, 10)", n)
+		}
+		if f := source.Float64(); f < 0 || f >= 1 {
+			t.Errorf("Float64() = %v, want a number in [0, 1)", f)
+		}
+	}
+	shuffled := Shuffle([]interface{}{1, 2, 3}, WithSource(source))
+	if len(shuffled) != 3 || !Contains(shuffled, 1) || !Contains(shuffled, 2) || !Contains(shuffled, 3) {
+		t.Errorf("Shuffle() = %v, want a permutation of %v", shuffled, []interface{}{1, 2, 3})
+	}
+}
+
+func Test_ShuffleUniform(t *testing.T) {
+	counts := map[string]int{}
+	option := WithSeed(1)
+	for i := 0; i < 6000; i++ {
+		counts[fmt.Sprint(Shuffle([]interface{}{1, 2, 3}, option))]++
+	}
+	if len(counts) != 6 {
+		t.Errorf("Shuffle() produced %v orders, want %v", len(counts), 6)
+	}
+	for order, count := range counts {
+		if count < 850 || count > 1150 {
+			t.Errorf("Shuffle() produced %v %v times, want about %v", order, count, 1000)
+		}
+	}
+}
+
+func Test_ChooseRandomEdgeCases(t *testing.T) {
+	if item := ChooseRandom([]interface{}{}); item != nil {
+		t.Errorf("ChooseRandom() = %v, want %v", item, nil)
+	}
+	if item := ChooseRandom([]interface{}{"only"}); item != "only" {
+		t.Errorf("ChooseRandom() = %v, want %v", item, "only")
+	}
+	var wg sync.WaitGroup
+	option := WithSeed(5)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ChooseRandom([]interface{}{1, 2, 3}, option)
+			ChooseRandom([]interface{}{1, 2, 3})
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_Sample(t *testing.T) {
+	items := []interface{}{1, 2, 3, 4, 5}
+	sampled := Sample(items, 3, WithSeed(1))
+	if len(sampled) != 3 || len(Uniq(sampled)) != 3 {
+		t.Errorf("Sample() = %v, want %v distinct items", sampled, 3)
+	}
+	if sampled := Sample(items, 10); len(sampled) != 5 {
+		t.Errorf("Sample() = %v, want %v items", sampled, 5)
+	}
+	if !reflect.DeepEqual(items, []interface{}{1, 2, 3, 4, 5}) {
+		t.Errorf("Sample() modified the source slice: %v", items)
+	}
+}
+
+func Test_SampleWeighted(t *testing.T) {
+	items := []interface{}{"never", "rare", "common"}
+	weights := []float64{0, 1, 9}
+	weight := func(index int, _ interface{}) float64 { return weights[index] }
+	counts := map[interface{}]int{}
+	option := WithSeed(2)
+	for i := 0; i < 1000; i++ {
+		counts[SampleWeighted(items, 1, weight, option)[0]]++
+	}
+	if counts["never"] != 0 || counts["common"] < 850 || counts["common"] > 950 {
+		t.Errorf("SampleWeighted() counts = %v, want about 100 rare and 900 common", counts)
+	}
+	if sampled := SampleWeighted(items, 3, weight, option); len(sampled) != 2 {
+		t.Errorf("SampleWeighted() = %v, want %v items", sampled, 2)
+	}
+}
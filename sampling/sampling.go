@@ -0,0 +1,167 @@
+// Package sampling picks items at random: uniformly without replacement,
+// weighted with per-item weights, repeatedly from a fixed distribution with
+// the alias method and from streams of unknown length with reservoir
+// sampling. Every function accepts the options of the random package, so
+// results can be made reproducible with random.WithSeed.
+package sampling
+
+import (
+	"strconv"
+
+	"github.com/rbrahul/gofp/generic"
+	"github.com/rbrahul/gofp/random"
+	"github.com/rbrahul/gofp/seq"
+)
+
+// SampleN returns a new slice of n items picked uniformly at random without replacement, all items in
+// random order if n exceeds the length
+func SampleN[T any](items []T, n int, opts ...random.Option) []T {
+	return generic.Sample(items, n, opts...)
+}
+
+// weightsOf returns the weight of every item and their sum, it panics if a weight is negative, NaN or infinite
+func weightsOf[T any](items []T, fn func(item T) float64) ([]float64, float64) {
+	return random.Weights(items, func(_ int, item T) float64 {
+		return fn(item)
+	})
+}
+
+// WeightedChoice returns an item picked with a chance proportional to the weight returned from the
+// function, ok is false if there are no items or all of them weigh 0
+func WeightedChoice[T any](items []T, fn func(item T) float64, opts ...random.Option) (item T, ok bool) {
+	weights, total := weightsOf(items, fn)
+	if total == 0 {
+		return item, false
+	}
+	target := random.SourceOf(opts...).Float64() * total
+	last := 0
+	for i, weight := range weights {
+		if weight == 0 {
+			continue
+		}
+		if target < weight {
+			return items[i], true
+		}
+		target -= weight
+		last = i
+	}
+	// rounding errors may leave a tiny remainder, it belongs to the last item with a weight
+	return items[last], true
+}
+
+// Alias draws items repeatedly from a fixed weighted distribution in constant time per draw, using
+// Vose's alias method. Building it takes linear time
+type Alias[T any] struct {
+	items       []T
+	probability []float64
+	alias       []int
+}
+
+// NewAlias returns an Alias drawing the items with a chance proportional to the weight returned from
+// the function. It panics if there are no items with a weight greater than 0
+func NewAlias[T any](items []T, fn func(item T) float64) *Alias[T] {
+	weights, total := weightsOf(items, fn)
+	if total == 0 {
+		panic("Invalid weights, at least one item must weigh more than 0")
+	}
+	length := len(items)
+	alias := &Alias[T]{items: items, probability: make([]float64, length), alias: make([]int, length)}
+	scaled := make([]float64, length)
+	small, large := []int{}, []int{}
+	for i, weight := range weights {
+		scaled[i] = weight * float64(length) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		less, more := small[len(small)-1], large[len(large)-1]
+		small, large = small[:len(small)-1], large[:len(large)-1]
+		alias.probability[less] = scaled[less]
+		alias.alias[less] = more
+		scaled[more] += scaled[less] - 1
+		if scaled[more] < 1 {
+			small = append(small, more)
+		} else {
+			large = append(large, more)
+		}
+	}
+	// whatever is left over only differs from 1 by rounding errors
+	for _, index := range append(small, large...) {
+		alias.probability[index] = 1
+	}
+	return alias
+}
+
+// Draw returns a random item
+func (a *Alias[T]) Draw(opts ...random.Option) T {
+	return a.draw(random.SourceOf(opts...))
+}
+
+// DrawN returns a new slice of n random items drawn with replacement
+func (a *Alias[T]) DrawN(n int, opts ...random.Option) []T {
+	source := random.SourceOf(opts...)
+	drawn := make([]T, max(n, 0))
+	for i := range drawn {
+		drawn[i] = a.draw(source)
+	}
+	return drawn
+}
+
+func (a *Alias[T]) draw(source random.Source) T {
+	index := source.Intn(len(a.items))
+	if source.Float64() < a.probability[index] {
+		return a.items[index]
+	}
+	return a.items[a.alias[index]]
+}
+
+// Reservoir keeps a uniform sample of at most size items out of every item added to it, without
+// knowing in advance how many items will arrive. It isn't safe for concurrent use
+type Reservoir[T any] struct {
+	size   int
+	seen   int
+	items  []T
+	source random.Source
+}
+
+// NewReservoir returns an empty Reservoir holding at most size items
+func NewReservoir[T any](size int, opts ...random.Option) *Reservoir[T] {
+	if size < 0 {
+		panic("Invalid reservoir size " + strconv.Itoa(size) + ", it must not be negative")
+	}
+	return &Reservoir[T]{size: size, items: make([]T, 0, size), source: random.SourceOf(opts...)}
+}
+
+// Add offers an item to the Reservoir, every item seen so far has the same chance to be kept
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.size {
+		r.items = append(r.items, item)
+		return
+	}
+	if index := r.source.Intn(r.seen); index < r.size {
+		r.items[index] = item
+	}
+}
+
+// Seen returns the number of items added so far
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// Items returns a copy of the sampled items
+func (r *Reservoir[T]) Items() []T {
+	return append([]T{}, r.items...)
+}
+
+// ReservoirSample returns a uniform sample of at most n items of the sequence, which is consumed once
+func ReservoirSample[T any](s seq.Seq[T], n int, opts ...random.Option) []T {
+	reservoir := NewReservoir[T](n, opts...)
+	for item := range s {
+		reservoir.Add(item)
+	}
+	return reservoir.Items()
+}
@@ -0,0 +1,105 @@
+package sampling
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rbrahul/gofp/generic"
+	"github.com/rbrahul/gofp/random"
+	"github.com/rbrahul/gofp/seq"
+)
+
+type variant struct {
+	name   string
+	weight float64
+}
+
+var variants = []variant{{"control", 0}, {"a", 1}, {"b", 3}}
+
+func weight(v variant) float64 { return v.weight }
+
+func Test_SampleN(t *testing.T) {
+	sampled := SampleN([]int{1, 2, 3, 4, 5}, 3, random.WithSeed(1))
+	if len(sampled) != 3 || len(generic.Uniq(sampled)) != 3 {
+		t.Errorf("SampleN() = %v, want %v distinct items", sampled, 3)
+	}
+	if !reflect.DeepEqual(sampled, SampleN([]int{1, 2, 3, 4, 5}, 3, random.WithSeed(1))) {
+		t.Errorf("SampleN() with the same seed returned different samples")
+	}
+}
+
+func Test_WeightedChoice(t *testing.T) {
+	option := random.WithSeed(2)
+	counts := map[string]int{}
+	for i := 0; i < 4000; i++ {
+		choice, ok := WeightedChoice(variants, weight, option)
+		if !ok {
+			t.Fatalf("WeightedChoice() ok = %v, want %v", ok, true)
+		}
+		counts[choice.name]++
+	}
+	if counts["control"] != 0 || counts["b"] < 2850 || counts["b"] > 3150 {
+		t.Errorf("WeightedChoice() counts = %v, want about 1000 a and 3000 b", counts)
+	}
+	if _, ok := WeightedChoice(variants[:1], weight); ok {
+		t.Errorf("WeightedChoice() ok = %v, want %v", ok, false)
+	}
+}
+
+func Test_Alias(t *testing.T) {
+	alias := NewAlias(variants, weight)
+	counts := generic.CountBy(alias.DrawN(4000, random.WithSeed(3)), func(v variant) string {
+		return v.name
+	})
+	if counts["control"] != 0 || counts["b"] < 2850 || counts["b"] > 3150 {
+		t.Errorf("DrawN() counts = %v, want about 1000 a and 3000 b", counts)
+	}
+	if drawn := alias.Draw(); drawn.name == "control" {
+		t.Errorf("Draw() = %v, want an item with a weight", drawn)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewAlias() with zero weights didn't panic")
+		}
+	}()
+	NewAlias(variants[:1], weight)
+}
+
+func Test_ReservoirSample(t *testing.T) {
+	stream := seq.Seq[int](func(yield func(int) bool) {
+		for i := 0; i < 10; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+	option := random.WithSeed(4)
+	counts := map[int]int{}
+	for i := 0; i < 5000; i++ {
+		sampled := ReservoirSample(stream, 3, option)
+		if len(sampled) != 3 || len(generic.Uniq(sampled)) != 3 {
+			t.Fatalf("ReservoirSample() = %v, want %v distinct items", sampled, 3)
+		}
+		for _, item := range sampled {
+			counts[item]++
+		}
+	}
+	for item, count := range counts {
+		if count < 1350 || count > 1650 {
+			t.Errorf("ReservoirSample() kept %v %v times, want about %v", item, count, 1500)
+		}
+	}
+	if sampled := ReservoirSample(seq.FromSlice([]int{1}), 3); !reflect.DeepEqual(sampled, []int{1}) {
+		t.Errorf("ReservoirSample() = %v, want %v", sampled, []int{1})
+	}
+}
+
+func Test_Reservoir(t *testing.T) {
+	reservoir := NewReservoir[string](2, random.WithSeed(5))
+	for _, line := range []string{"a", "b", "c", "d"} {
+		reservoir.Add(line)
+	}
+	if reservoir.Seen() != 4 || len(reservoir.Items()) != 2 {
+		t.Errorf("Reservoir = %v seen, %v, want %v seen, %v items", reservoir.Seen(), reservoir.Items(), 4, 2)
+	}
+}
@@ -0,0 +1,22 @@
+package seq_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rbrahul/gofp"
+	"github.com/rbrahul/gofp/seq"
+)
+
+func Test_InteropWithSliceFunctions(t *testing.T) {
+	items := seq.FromSlice([]interface{}{1, 2, 3, 4}).Filter(func(item interface{}) bool {
+		return item.(int)%2 == 0
+	}).Collect()
+	got := gofp.Map(items, func(i int, item interface{}) interface{} {
+		return item.(int) * 10
+	})
+	want := []interface{}{20, 40}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Collect() = %v, want %v", got, want)
+	}
+}
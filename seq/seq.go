@@ -0,0 +1,373 @@
+// Package seq provides lazy sequences with chainable operators. Unlike the
+// slice based functions of gofp, the operators don't allocate intermediate
+// slices: values are only computed when a terminal operation such as Collect,
+// Reduce or Find pulls them, and terminals stop as soon as the result is known.
+//
+// Seq has the same underlying type as iter.Seq, so a sequence can be ranged
+// over directly and converted to and from iter.Seq.
+package seq
+
+import "iter"
+
+// Seq is a lazy sequence of values of type T
+type Seq[T any] func(yield func(T) bool)
+
+// Pair holds the values produced together by Zip
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+// From returns a Seq wrapping the given iter.Seq
+func From[T any](s iter.Seq[T]) Seq[T] {
+	return Seq[T](s)
+}
+
+// FromSlice returns a Seq which yields the items of the slice in order
+func FromSlice[T any](items []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a new slice with all the values of the sequence
+func ToSlice[T any](s Seq[T]) []T {
+	return s.Collect()
+}
+
+// Iter returns the sequence as an iter.Seq
+func (s Seq[T]) Iter() iter.Seq[T] {
+	return iter.Seq[T](s)
+}
+
+// Filter returns a sequence of values which satisfy the condition
+func (s Seq[T]) Filter(fn func(item T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if fn(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of at most the first n values
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for item := range s {
+			if !yield(item) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence without the first n values
+func (s Seq[T]) Skip(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for item := range s {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns a sequence of the leading values which satisfy the condition
+func (s Seq[T]) TakeWhile(fn func(item T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if !fn(item) || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// DropWhile returns a sequence without the leading values which satisfy the condition
+func (s Seq[T]) DropWhile(fn func(item T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		dropping := true
+		for item := range s {
+			if dropping && fn(item) {
+				continue
+			}
+			dropping = false
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns a new slice with all the values of the sequence
+func (s Seq[T]) Collect() []T {
+	items := []T{}
+	for item := range s {
+		items = append(items, item)
+	}
+	return items
+}
+
+// Find returns the first value which satisfies the condition, ok is false if there is no such value
+func (s Seq[T]) Find(fn func(item T) bool) (found T, ok bool) {
+	for item := range s {
+		if fn(item) {
+			return item, true
+		}
+	}
+	return found, false
+}
+
+// Any returns true if any of the values satisfies the condition
+func (s Seq[T]) Any(fn func(item T) bool) bool {
+	_, ok := s.Find(fn)
+	return ok
+}
+
+// Every returns true if all the values satisfy the condition
+func (s Seq[T]) Every(fn func(item T) bool) bool {
+	for item := range s {
+		if !fn(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Map returns a sequence of transformed values
+func Map[T, U any](s Seq[T], fn func(item T) U) Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range s {
+			if !yield(fn(item)) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns a sequence of the values of every sequence produced by the function
+func FlatMap[T, U any](s Seq[T], fn func(item T) Seq[U]) Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range s {
+			for value := range fn(item) {
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Zip returns a sequence of pairs taking one value from each sequence, it stops when either sequence ends
+func Zip[T, U any](first Seq[T], second Seq[U]) Seq[Pair[T, U]] {
+	return func(yield func(Pair[T, U]) bool) {
+		nextFirst, stopFirst := iter.Pull(first.Iter())
+		defer stopFirst()
+		nextSecond, stopSecond := iter.Pull(second.Iter())
+		defer stopSecond()
+		for {
+			item, ok := nextFirst()
+			if !ok {
+				return
+			}
+			value, ok := nextSecond()
+			if !ok || !yield(Pair[T, U]{First: item, Second: value}) {
+				return
+			}
+		}
+	}
+}
+
+// ZipWith returns a sequence of the values returned from fn called with one value from each sequence,
+// it stops when either sequence ends
+func ZipWith[T, U, R any](first Seq[T], second Seq[U], fn func(a T, b U) R) Seq[R] {
+	return Map(Zip(first, second), func(pair Pair[T, U]) R {
+		return fn(pair.First, pair.Second)
+	})
+}
+
+// ZipLongest works like Zip but continues until both sequences end, the missing values of the
+// shorter sequence are replaced by the fill values
+func ZipLongest[T, U any](first Seq[T], second Seq[U], firstFill T, secondFill U) Seq[Pair[T, U]] {
+	return func(yield func(Pair[T, U]) bool) {
+		nextFirst, stopFirst := iter.Pull(first.Iter())
+		defer stopFirst()
+		nextSecond, stopSecond := iter.Pull(second.Iter())
+		defer stopSecond()
+		for {
+			item, firstOk := nextFirst()
+			value, secondOk := nextSecond()
+			if !firstOk && !secondOk {
+				return
+			}
+			if !firstOk {
+				item = firstFill
+			}
+			if !secondOk {
+				value = secondFill
+			}
+			if !yield(Pair[T, U]{First: item, Second: value}) {
+				return
+			}
+		}
+	}
+}
+
+// ZipN returns a sequence of slices taking one value from every sequence, it stops when any sequence ends
+func ZipN[T any](seqs ...Seq[T]) Seq[[]T] {
+	var zero T
+	return zipN(seqs, false, zero)
+}
+
+// ZipNLongest works like ZipN but continues until every sequence ends, the missing values are replaced by fill
+func ZipNLongest[T any](fill T, seqs ...Seq[T]) Seq[[]T] {
+	return zipN(seqs, true, fill)
+}
+
+func zipN[T any](seqs []Seq[T], longest bool, fill T) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, s := range seqs {
+			next, stop := iter.Pull(s.Iter())
+			defer stop()
+			nexts[i] = next
+		}
+		for {
+			row := make([]T, len(seqs))
+			active := 0
+			for i, next := range nexts {
+				value, ok := next()
+				switch {
+				case ok:
+					active++
+				case !longest:
+					return
+				default:
+					value = fill
+				}
+				row[i] = value
+			}
+			if active == 0 || !yield(row) {
+				return
+			}
+		}
+	}
+}
+
+// Unzip splits a sequence of pairs into a sequence of first and a sequence of second values. Each of
+// them iterates the source sequence on its own
+func Unzip[T, U any](s Seq[Pair[T, U]]) (Seq[T], Seq[U]) {
+	first := Map(s, func(pair Pair[T, U]) T {
+		return pair.First
+	})
+	second := Map(s, func(pair Pair[T, U]) U {
+		return pair.Second
+	})
+	return first, second
+}
+
+// Interleave returns a sequence taking one value from every sequence in turn, skipping the sequences which ended
+func Interleave[T any](seqs ...Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		nexts := make([]func() (T, bool), len(seqs))
+		for i, s := range seqs {
+			next, stop := iter.Pull(s.Iter())
+			defer stop()
+			nexts[i] = next
+		}
+		for active := len(nexts); active > 0; {
+			for i, next := range nexts {
+				if next == nil {
+					continue
+				}
+				value, ok := next()
+				if !ok {
+					nexts[i] = nil
+					active--
+					continue
+				}
+				if !yield(value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// CartesianProduct returns a sequence of every combination taking one value from each sequence, the
+// last sequence varies fastest. Every sequence but the first is iterated again for each combination
+// of the preceding values, so they must produce the same values each time
+func CartesianProduct[T any](seqs ...Seq[T]) Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if len(seqs) == 0 {
+			return
+		}
+		row := make([]T, len(seqs))
+		var walk func(depth int) bool
+		walk = func(depth int) bool {
+			if depth == len(seqs) {
+				return yield(append([]T{}, row...))
+			}
+			for value := range seqs[depth] {
+				row[depth] = value
+				if !walk(depth + 1) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(0)
+	}
+}
+
+// Chunk returns a sequence of slices. Every slice has at most size number of values
+func Chunk[T any](s Seq[T], size int) Seq[[]T] {
+	if size <= 0 {
+		panic("Invalid chunk size, size must be greater than 0")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for item := range s {
+			chunk = append(chunk, item)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Reduce iterates over all the values of the sequence and returns accumulated result
+func Reduce[T, A any](s Seq[T], fn func(current T, accumulator A) A, initialValue A) A {
+	accumulator := initialValue
+	for item := range s {
+		accumulator = fn(item, accumulator)
+	}
+	return accumulator
+}
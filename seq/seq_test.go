@@ -0,0 +1,225 @@
+package seq
+
+import (
+	"reflect"
+	"testing"
+)
+
+// naturals yields 1, 2, 3... and records how many values were produced
+func naturals(produced *int) Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 1; ; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func Test_FromSlice(t *testing.T) {
+	got := ToSlice(FromSlice([]int{1, 2, 3}))
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromSlice() = %v, want %v", got, want)
+	}
+}
+
+func Test_From(t *testing.T) {
+	s := FromSlice([]string{"a", "b"})
+	got := From(s.Iter()).Collect()
+	want := []string{"a", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("From() = %v, want %v", got, want)
+	}
+}
+
+func Test_Filter(t *testing.T) {
+	got := FromSlice([]int{12, 16, 18, 20, 23, 40, 25}).Filter(func(age int) bool {
+		return age >= 20
+	}).Collect()
+	want := []int{20, 23, 40, 25}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Filter() = %v, want %v", got, want)
+	}
+}
+
+func Test_Take(t *testing.T) {
+	produced := 0
+	got := naturals(&produced).Take(3).Collect()
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) || produced != 3 {
+		t.Errorf("Take() = %v (produced %v), want %v (produced %v)", got, produced, want, 3)
+	}
+}
+
+func Test_Skip(t *testing.T) {
+	got := FromSlice([]int{1, 2, 3, 4, 5}).Skip(2).Collect()
+	want := []int{3, 4, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Skip() = %v, want %v", got, want)
+	}
+}
+
+func Test_TakeWhile(t *testing.T) {
+	produced := 0
+	got := naturals(&produced).TakeWhile(func(item int) bool {
+		return item < 4
+	}).Collect()
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhile() = %v, want %v", got, want)
+	}
+}
+
+func Test_DropWhile(t *testing.T) {
+	got := FromSlice([]int{1, 2, 5, 1, 2}).DropWhile(func(item int) bool {
+		return item < 3
+	}).Collect()
+	want := []int{5, 1, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DropWhile() = %v, want %v", got, want)
+	}
+}
+
+func Test_Find(t *testing.T) {
+	produced := 0
+	got, ok := naturals(&produced).Find(func(item int) bool {
+		return item*item > 50
+	})
+	if !ok || got != 8 || produced != 8 {
+		t.Errorf("Find() = %v (produced %v), want %v (produced %v)", got, produced, 8, 8)
+	}
+}
+
+func Test_Any(t *testing.T) {
+	produced := 0
+	got := naturals(&produced).Any(func(item int) bool {
+		return item == 5
+	})
+	if !got || produced != 5 {
+		t.Errorf("Any() = %v (produced %v), want %v (produced %v)", got, produced, true, 5)
+	}
+}
+
+func Test_Every(t *testing.T) {
+	produced := 0
+	got := naturals(&produced).Every(func(item int) bool {
+		return item < 3
+	})
+	if got || produced != 3 {
+		t.Errorf("Every() = %v (produced %v), want %v (produced %v)", got, produced, false, 3)
+	}
+}
+
+func Test_Map(t *testing.T) {
+	calls := 0
+	mapped := Map(FromSlice([]int{1, 2, 3, 4, 5}), func(item int) int {
+		calls++
+		return item * item
+	})
+	if calls != 0 {
+		t.Errorf("Map() calls before terminal = %v, want %v", calls, 0)
+	}
+	got := mapped.Take(2).Collect()
+	want := []int{1, 4}
+	if !reflect.DeepEqual(got, want) || calls != 2 {
+		t.Errorf("Map() = %v (calls %v), want %v (calls %v)", got, calls, want, 2)
+	}
+}
+
+func Test_FlatMap(t *testing.T) {
+	got := FlatMap(FromSlice([]int{1, 2, 3}), func(item int) Seq[int] {
+		return FromSlice([]int{item, item * 10})
+	}).Take(5).Collect()
+	want := []int{1, 10, 2, 20, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FlatMap() = %v, want %v", got, want)
+	}
+}
+
+func Test_Zip(t *testing.T) {
+	produced := 0
+	got := Zip(FromSlice([]string{"a", "b", "c"}), naturals(&produced)).Collect()
+	want := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+}
+
+func Test_ZipWith(t *testing.T) {
+	produced := 0
+	got := ZipWith(FromSlice([]int{10, 20}), naturals(&produced), func(a int, b int) int {
+		return a + b
+	}).Collect()
+	if want := []int{11, 22}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipWith() = %v, want %v", got, want)
+	}
+}
+
+func Test_ZipLongest(t *testing.T) {
+	got := ZipLongest(FromSlice([]string{"a"}), FromSlice([]int{1, 2}), "-", 0).Collect()
+	want := []Pair[string, int]{{"a", 1}, {"-", 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipLongest() = %v, want %v", got, want)
+	}
+}
+
+func Test_ZipN(t *testing.T) {
+	produced := 0
+	got := ZipN(FromSlice([]int{7, 8, 9}), naturals(&produced), FromSlice([]int{0, 0})).Collect()
+	if want := [][]int{{7, 1, 0}, {8, 2, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipN() = %v, want %v", got, want)
+	}
+	got = ZipNLongest(-1, FromSlice([]int{1, 2}), FromSlice([]int{3})).Collect()
+	if want := [][]int{{1, 3}, {2, -1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ZipNLongest() = %v, want %v", got, want)
+	}
+}
+
+func Test_Unzip(t *testing.T) {
+	first, second := Unzip(Zip(FromSlice([]string{"a", "b"}), FromSlice([]int{1, 2})))
+	if got := first.Collect(); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("Unzip() first = %v, want %v", got, []string{"a", "b"})
+	}
+	if got := second.Collect(); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Unzip() second = %v, want %v", got, []int{1, 2})
+	}
+}
+
+func Test_Interleave(t *testing.T) {
+	produced := 0
+	got := Interleave(FromSlice([]int{100, 200}), naturals(&produced)).Take(6).Collect()
+	if want := []int{100, 1, 200, 2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Interleave() = %v, want %v", got, want)
+	}
+}
+
+func Test_CartesianProduct(t *testing.T) {
+	got := CartesianProduct(FromSlice([]int{1, 2}), FromSlice([]int{3, 4})).Collect()
+	if want := [][]int{{1, 3}, {1, 4}, {2, 3}, {2, 4}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CartesianProduct() = %v, want %v", got, want)
+	}
+	produced := 0
+	got = CartesianProduct(naturals(&produced), FromSlice([]int{0})).Take(2).Collect()
+	if want := [][]int{{1, 0}, {2, 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("CartesianProduct() = %v, want %v", got, want)
+	}
+}
+
+func Test_Chunk(t *testing.T) {
+	got := Chunk(FromSlice([]int{1, 2, 3, 4, 5}), 2).Collect()
+	want := [][]int{{1, 2}, {3, 4}, {5}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Chunk() = %v, want %v", got, want)
+	}
+}
+
+func Test_Reduce(t *testing.T) {
+	got := Reduce(FromSlice([]int{10, 20, 30, 40}), func(current int, accumulator int) int {
+		return accumulator + current
+	}, 0)
+	if got != 100 {
+		t.Errorf("Reduce() = %v, want %v", got, 100)
+	}
+}
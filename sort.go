@@ -0,0 +1,229 @@
+package gofp
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rbrahul/gofp/generic"
+)
+
+// OrderKey is a sort key of OrderBy, created with Asc or Desc
+type OrderKey struct {
+	key        func(item interface{}) interface{}
+	descending bool
+}
+
+// Asc returns an OrderKey which sorts by the key returned from the function in ascending order
+func Asc(fn func(item interface{}) interface{}) OrderKey {
+	return OrderKey{key: fn}
+}
+
+// Desc returns an OrderKey which sorts by the key returned from the function in descending order
+func Desc(fn func(item interface{}) interface{}) OrderKey {
+	return OrderKey{key: fn, descending: true}
+}
+
+type keyedItem struct {
+	keys []interface{}
+	item interface{}
+}
+
+// compareKeys compares two sort keys. Numbers of any type, strings, booleans and time.Time are
+// supported and nil sorts before everything else, it panics for any other combination of types
+func compareKeys(a interface{}, b interface{}) int {
+	if number, ok := a.(json.Number); ok {
+		a, _ = number.Float64()
+	}
+	if number, ok := b.(json.Number); ok {
+		b, _ = number.Float64()
+	}
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	if x, ok := a.(time.Time); ok {
+		if y, ok := b.(time.Time); ok {
+			return x.Compare(y)
+		}
+	}
+	x, y := reflect.ValueOf(a), reflect.ValueOf(b)
+	switch {
+	case x.CanInt() && y.CanInt():
+		return cmp.Compare(x.Int(), y.Int())
+	case x.CanUint() && y.CanUint():
+		return cmp.Compare(x.Uint(), y.Uint())
+	case x.Kind() == reflect.String && y.Kind() == reflect.String:
+		return strings.Compare(x.String(), y.String())
+	case x.Kind() == reflect.Bool && y.Kind() == reflect.Bool:
+		switch {
+		case x.Bool() == y.Bool():
+			return 0
+		case y.Bool():
+			return -1
+		}
+		return 1
+	}
+	if x, ok := toFloat64(a); ok {
+		if y, ok := toFloat64(b); ok {
+			return cmp.Compare(x, y)
+		}
+	}
+	panic(fmt.Sprintf("Invalid sort keys of type %T and %T, keys must be numbers, strings, booleans or time.Time", a, b))
+}
+
+// keyItems calls every key function once per item
+func keyItems(items []interface{}, keys ...func(item interface{}) interface{}) []keyedItem {
+	keyed := make([]keyedItem, len(items))
+	for i, item := range items {
+		keyed[i] = keyedItem{keys: make([]interface{}, len(keys)), item: item}
+		for j, key := range keys {
+			keyed[i].keys[j] = key(item)
+		}
+	}
+	return keyed
+}
+
+func itemsOf(keyed []keyedItem) []interface{} {
+	items := make([]interface{}, len(keyed))
+	for i, entry := range keyed {
+		items[i] = entry.item
+	}
+	return items
+}
+
+func sortKeyed(items []interface{}, fn func(item interface{}) interface{}, stable bool) []interface{} {
+	keyed := keyItems(items, fn)
+	less := func(i, j int) bool {
+		return compareKeys(keyed[i].keys[0], keyed[j].keys[0]) < 0
+	}
+	if stable {
+		sort.SliceStable(keyed, less)
+	} else {
+		sort.Slice(keyed, less)
+	}
+	return itemsOf(keyed)
+}
+
+func sortCopy(items []interface{}, less func(a interface{}, b interface{}) bool, stable bool) []interface{} {
+	sorted := make([]interface{}, len(items))
+	copy(sorted, items)
+	lessIndex := func(i, j int) bool {
+		return less(sorted[i], sorted[j])
+	}
+	if stable {
+		sort.SliceStable(sorted, lessIndex)
+	} else {
+		sort.Slice(sorted, lessIndex)
+	}
+	return sorted
+}
+
+// SortBy returns a new slice sorted in ascending order by the key returned from the function
+func SortBy(items []interface{}, fn func(item interface{}) interface{}) []interface{} {
+	return sortKeyed(items, fn, false)
+}
+
+// StableSortBy works like SortBy and keeps the original order of items with equal keys
+func StableSortBy(items []interface{}, fn func(item interface{}) interface{}) []interface{} {
+	return sortKeyed(items, fn, true)
+}
+
+// SortWith returns a new slice sorted with the less function
+func SortWith(items []interface{}, less func(a interface{}, b interface{}) bool) []interface{} {
+	return sortCopy(items, less, false)
+}
+
+// StableSortWith works like SortWith and keeps the original order of equal items
+func StableSortWith(items []interface{}, less func(a interface{}, b interface{}) bool) []interface{} {
+	return sortCopy(items, less, true)
+}
+
+// OrderBy returns a new slice sorted by the keys in turn, later keys break the ties of earlier ones.
+// The sort is stable
+func OrderBy(items []interface{}, keys ...OrderKey) []interface{} {
+	fns := make([]func(item interface{}) interface{}, len(keys))
+	for i, key := range keys {
+		fns[i] = key.key
+	}
+	keyed := keyItems(items, fns...)
+	sort.SliceStable(keyed, func(i, j int) bool {
+		for k, key := range keys {
+			result := compareKeys(keyed[i].keys[k], keyed[j].keys[k])
+			if key.descending {
+				result = -result
+			}
+			if result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+	return itemsOf(keyed)
+}
+
+// MinBy returns the first item with the smallest key returned from the function, nil if the slice is empty
+func MinBy(items []interface{}, fn func(item interface{}) interface{}) interface{} {
+	best := BottomK(items, 1, fn)
+	if len(best) == 0 {
+		return nil
+	}
+	return best[0]
+}
+
+// MaxBy returns the first item with the largest key returned from the function, nil if the slice is empty
+func MaxBy(items []interface{}, fn func(item interface{}) interface{}) interface{} {
+	best := TopK(items, 1, fn)
+	if len(best) == 0 {
+		return nil
+	}
+	return best[0]
+}
+
+// TopK returns a new slice of the k items with the largest keys, largest first. Items with equal keys
+// keep their original order
+func TopK(items []interface{}, k int, fn func(item interface{}) interface{}) []interface{} {
+	return itemsOf(generic.TopKFunc(keyItems(items, fn), k, compareFirstKeys))
+}
+
+// BottomK returns a new slice of the k items with the smallest keys, smallest first. Items with equal
+// keys keep their original order
+func BottomK(items []interface{}, k int, fn func(item interface{}) interface{}) []interface{} {
+	return itemsOf(generic.BottomKFunc(keyItems(items, fn), k, compareFirstKeys))
+}
+
+func compareFirstKeys(a keyedItem, b keyedItem) int {
+	return compareKeys(a.keys[0], b.keys[0])
+}
+
+// IsSorted returns true if the items are in ascending order
+func IsSorted(items []interface{}) bool {
+	return IsSortedWith(items, func(a interface{}, b interface{}) bool {
+		return compareKeys(a, b) < 0
+	})
+}
+
+// IsSortedBy returns true if the keys returned from the function are in ascending order
+func IsSortedBy(items []interface{}, fn func(item interface{}) interface{}) bool {
+	return IsSorted(Map(items, func(_ int, item interface{}) interface{} {
+		return fn(item)
+	}))
+}
+
+// IsSortedWith returns true if the items are in order according to the less function
+func IsSortedWith(items []interface{}, less func(a interface{}, b interface{}) bool) bool {
+	for i := 1; i < len(items); i++ {
+		if less(items[i], items[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,131 @@
+package gofp
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+var employees = []interface{}{
+	map[string]interface{}{"name": "Ron", "team": "ops", "age": 30},
+	map[string]interface{}{"name": "Sofia", "team": "dev", "age": 25},
+	map[string]interface{}{"name": "Raymond", "team": "dev", "age": 41},
+	map[string]interface{}{"name": "Roni", "team": "ops", "age": 25},
+}
+
+func field(name string) func(item interface{}) interface{} {
+	return func(item interface{}) interface{} {
+		return item.(map[string]interface{})[name]
+	}
+}
+
+func names(items []interface{}) []interface{} {
+	return Map(items, func(_ int, item interface{}) interface{} {
+		return item.(map[string]interface{})["name"]
+	})
+}
+
+func Test_SortBy(t *testing.T) {
+	sorted := names(SortBy(employees, field("name")))
+	if want := []interface{}{"Raymond", "Ron", "Roni", "Sofia"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("SortBy() = %v, want %v", sorted, want)
+	}
+	if names(employees)[0] != "Ron" {
+		t.Errorf("SortBy() modified the source slice")
+	}
+}
+
+func Test_StableSortBy(t *testing.T) {
+	sorted := names(StableSortBy(employees, field("age")))
+	if want := []interface{}{"Sofia", "Roni", "Ron", "Raymond"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("StableSortBy() = %v, want %v", sorted, want)
+	}
+}
+
+func Test_SortWith(t *testing.T) {
+	sorted := SortWith([]interface{}{3, 1, 2}, func(a interface{}, b interface{}) bool {
+		return a.(int) > b.(int)
+	})
+	if want := []interface{}{3, 2, 1}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("SortWith() = %v, want %v", sorted, want)
+	}
+	sorted = StableSortWith(employees, func(a interface{}, b interface{}) bool {
+		return field("team")(a).(string) < field("team")(b).(string)
+	})
+	if want := []interface{}{"Sofia", "Raymond", "Ron", "Roni"}; !reflect.DeepEqual(names(sorted), want) {
+		t.Errorf("StableSortWith() = %v, want %v", names(sorted), want)
+	}
+}
+
+func Test_OrderBy(t *testing.T) {
+	sorted := names(OrderBy(employees, Asc(field("team")), Desc(field("age"))))
+	if want := []interface{}{"Raymond", "Sofia", "Ron", "Roni"}; !reflect.DeepEqual(sorted, want) {
+		t.Errorf("OrderBy() = %v, want %v", sorted, want)
+	}
+}
+
+func Test_compareKeys(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		a, b interface{}
+		want int
+	}{
+		{1, 2.5, -1},
+		{uint8(3), -1, 1},
+		{json.Number("10"), 9, 1},
+		{"b", "a", 1},
+		{false, true, -1},
+		{nil, 0, -1},
+		{now, now.Add(-time.Second), 1},
+	}
+	for _, tt := range tests {
+		if got := compareKeys(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareKeys(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("compareKeys() with a string and a number didn't panic")
+		}
+	}()
+	compareKeys("1", 1)
+}
+
+func Test_MinByMaxBy(t *testing.T) {
+	if youngest := field("name")(MinBy(employees, field("age"))); youngest != "Sofia" {
+		t.Errorf("MinBy() = %v, want %v", youngest, "Sofia")
+	}
+	if oldest := field("name")(MaxBy(employees, field("age"))); oldest != "Raymond" {
+		t.Errorf("MaxBy() = %v, want %v", oldest, "Raymond")
+	}
+	if item := MinBy([]interface{}{}, field("age")); item != nil {
+		t.Errorf("MinBy() = %v, want %v", item, nil)
+	}
+}
+
+func Test_TopK(t *testing.T) {
+	items := []interface{}{5, 1, 9, 3, 9, 7}
+	identity := func(item interface{}) interface{} { return item }
+	if top := TopK(items, 3, identity); !reflect.DeepEqual(top, []interface{}{9, 9, 7}) {
+		t.Errorf("TopK() = %v, want %v", top, []interface{}{9, 9, 7})
+	}
+	if bottom := BottomK(items, 2, identity); !reflect.DeepEqual(bottom, []interface{}{1, 3}) {
+		t.Errorf("BottomK() = %v, want %v", bottom, []interface{}{1, 3})
+	}
+	if top := TopK(items, 10, identity); len(top) != len(items) {
+		t.Errorf("TopK() = %v, want %v items", top, len(items))
+	}
+	if top := names(TopK(employees, 2, field("team"))); !reflect.DeepEqual(top, []interface{}{"Ron", "Roni"}) {
+		t.Errorf("TopK() = %v, want %v", top, []interface{}{"Ron", "Roni"})
+	}
+}
+
+func Test_IsSorted(t *testing.T) {
+	if !IsSorted([]interface{}{1, 2, 2, 3.5}) || IsSorted([]interface{}{"b", "a"}) {
+		t.Errorf("IsSorted() = %v, want %v", IsSorted([]interface{}{"b", "a"}), false)
+	}
+	if !IsSortedBy(SortBy(employees, field("age")), field("age")) {
+		t.Errorf("IsSortedBy() = %v, want %v", false, true)
+	}
+}
@@ -0,0 +1,125 @@
+package stats
+
+import (
+	"math"
+
+	"github.com/rbrahul/gofp/generic"
+	"github.com/rbrahul/gofp/seq"
+)
+
+// Accumulator computes count, sum, mean, variance, min and max in a single pass with Welford's
+// algorithm, so values don't have to be kept in memory. The zero value is ready to use
+type Accumulator struct {
+	count int
+	sum   float64
+	mean  float64
+	m2    float64
+	min   float64
+	max   float64
+}
+
+// Accumulate returns an Accumulator holding the values
+func Accumulate[T generic.Number](values []T) *Accumulator {
+	return AccumulateSeq(seq.FromSlice(values))
+}
+
+// AccumulateSeq returns an Accumulator holding every value of the sequence
+func AccumulateSeq[T generic.Number](s seq.Seq[T]) *Accumulator {
+	accumulator := &Accumulator{}
+	for value := range s {
+		accumulator.Add(float64(value))
+	}
+	return accumulator
+}
+
+// Add adds a value to the Accumulator
+func (a *Accumulator) Add(value float64) {
+	a.count++
+	a.sum += value
+	if a.count == 1 {
+		a.min, a.max = value, value
+	} else {
+		a.min, a.max = math.Min(a.min, value), math.Max(a.max, value)
+	}
+	delta := value - a.mean
+	a.mean += delta / float64(a.count)
+	a.m2 += delta * (value - a.mean)
+}
+
+// Merge adds every value held by other to the Accumulator, as if they had been added one by one
+func (a *Accumulator) Merge(other *Accumulator) {
+	if other.count == 0 {
+		return
+	}
+	if a.count == 0 {
+		*a = *other
+		return
+	}
+	count := a.count + other.count
+	delta := other.mean - a.mean
+	a.mean += delta * float64(other.count) / float64(count)
+	a.m2 += other.m2 + delta*delta*float64(a.count)*float64(other.count)/float64(count)
+	a.sum += other.sum
+	a.min, a.max = math.Min(a.min, other.min), math.Max(a.max, other.max)
+	a.count = count
+}
+
+// Count returns the number of values
+func (a *Accumulator) Count() int {
+	return a.count
+}
+
+// Sum returns the sum of the values
+func (a *Accumulator) Sum() float64 {
+	return a.sum
+}
+
+// Mean returns the arithmetic mean of the values, NaN if there are none
+func (a *Accumulator) Mean() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.mean
+}
+
+// Variance returns the population variance of the values, NaN if there are none
+func (a *Accumulator) Variance() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.m2 / float64(a.count)
+}
+
+// SampleVariance returns the sample variance of the values, NaN if there are less than two
+func (a *Accumulator) SampleVariance() float64 {
+	if a.count < 2 {
+		return math.NaN()
+	}
+	return a.m2 / float64(a.count-1)
+}
+
+// StdDev returns the population standard deviation of the values, NaN if there are none
+func (a *Accumulator) StdDev() float64 {
+	return math.Sqrt(a.Variance())
+}
+
+// SampleStdDev returns the sample standard deviation of the values, NaN if there are less than two
+func (a *Accumulator) SampleStdDev() float64 {
+	return math.Sqrt(a.SampleVariance())
+}
+
+// Min returns the smallest value, NaN if there are none
+func (a *Accumulator) Min() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.min
+}
+
+// Max returns the largest value, NaN if there are none
+func (a *Accumulator) Max() float64 {
+	if a.count == 0 {
+		return math.NaN()
+	}
+	return a.max
+}
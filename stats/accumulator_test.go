@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rbrahul/gofp/seq"
+)
+
+func Test_AccumulateSeq(t *testing.T) {
+	readings := seq.Map(seq.FromSlice(values), func(value int) float64 {
+		return float64(value) * 10
+	})
+	accumulator := AccumulateSeq(readings)
+	if accumulator.Count() != 8 || accumulator.Sum() != 400 || accumulator.Mean() != 50 {
+		t.Errorf("AccumulateSeq() = %v, %v, %v, want %v, %v, %v", accumulator.Count(), accumulator.Sum(), accumulator.Mean(), 8, 400, 50)
+	}
+	if !closeTo(accumulator.StdDev(), 20) || accumulator.Min() != 20 || accumulator.Max() != 90 {
+		t.Errorf("AccumulateSeq() = %v, %v, %v, want %v, %v, %v", accumulator.StdDev(), accumulator.Min(), accumulator.Max(), 20, 20, 90)
+	}
+}
+
+func Test_AccumulatorZeroValue(t *testing.T) {
+	var accumulator Accumulator
+	if !math.IsNaN(accumulator.Mean()) || !math.IsNaN(accumulator.Min()) || accumulator.Count() != 0 {
+		t.Errorf("Accumulator{} = %v, %v, want %v, %v", accumulator.Mean(), accumulator.Min(), math.NaN(), math.NaN())
+	}
+	accumulator.Add(-3)
+	if accumulator.Max() != -3 || accumulator.Variance() != 0 {
+		t.Errorf("Add() = %v, %v, want %v, %v", accumulator.Max(), accumulator.Variance(), -3, 0)
+	}
+}
+
+func Test_AccumulatorMerge(t *testing.T) {
+	merged := Accumulate(values[:3])
+	merged.Merge(Accumulate(values[3:]))
+	whole := Accumulate(values)
+	if merged.Count() != whole.Count() || !closeTo(merged.Mean(), whole.Mean()) || !closeTo(merged.SampleVariance(), whole.SampleVariance()) {
+		t.Errorf("Merge() = %v, want %v", merged, whole)
+	}
+	if merged.Min() != 2 || merged.Max() != 9 {
+		t.Errorf("Merge() min/max = %v, %v, want %v, %v", merged.Min(), merged.Max(), 2, 9)
+	}
+}
@@ -0,0 +1,185 @@
+// Package stats provides descriptive statistics for slices of any numeric
+// type. Functions which can't produce a meaningful result for an empty slice
+// return NaN, and an Accumulator computes the same statistics in a single
+// pass over values arriving from a lazy sequence.
+package stats
+
+import (
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/rbrahul/gofp/generic"
+)
+
+// Interpolation decides how Quantile estimates a value which falls between two data points
+type Interpolation int
+
+const (
+	// Linear interpolates between the two data points, it's the default
+	Linear Interpolation = iota
+	// Lower picks the lower data point
+	Lower
+	// Higher picks the higher data point
+	Higher
+	// Nearest picks the nearest data point, the even one when both are equally near
+	Nearest
+	// Midpoint picks the mean of the two data points
+	Midpoint
+)
+
+// QuantileOption configures Quantile and Percentile
+type QuantileOption func(*quantileConfig)
+
+type quantileConfig struct {
+	interpolation Interpolation
+}
+
+// WithInterpolation sets how values between two data points are estimated
+func WithInterpolation(interpolation Interpolation) QuantileOption {
+	return func(config *quantileConfig) {
+		config.interpolation = interpolation
+	}
+}
+
+// Bucket is a range of a Histogram together with the number of values in it. A value belongs to
+// the bucket if it's at least Min and less than Max, the last bucket also includes its Max
+type Bucket struct {
+	Min   float64
+	Max   float64
+	Count int
+}
+
+// Sum returns the sum of the values
+func Sum[T generic.Number](values []T) T {
+	var sum T
+	for _, value := range values {
+		sum += value
+	}
+	return sum
+}
+
+// Mean returns the arithmetic mean of the values, NaN if there are none
+func Mean[T generic.Number](values []T) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sum := 0.0
+	for _, value := range values {
+		sum += float64(value)
+	}
+	return sum / float64(len(values))
+}
+
+// Median returns the middle value or the mean of the two middle values, NaN if there are none
+func Median[T generic.Number](values []T) float64 {
+	return Quantile(values, 0.5)
+}
+
+// Mode returns the most frequent values in the order of their first occurrence
+func Mode[T generic.Number](values []T) []T {
+	counts := generic.CountBy(values, func(value T) T {
+		return value
+	})
+	highest := 0
+	for _, count := range counts {
+		highest = max(highest, count)
+	}
+	return generic.Filter(generic.Uniq(values), func(_ int, value T) bool {
+		return counts[value] == highest
+	})
+}
+
+// Variance returns the population variance of the values, NaN if there are none
+func Variance[T generic.Number](values []T) float64 {
+	return Accumulate(values).Variance()
+}
+
+// SampleVariance returns the sample variance of the values, NaN if there are less than two
+func SampleVariance[T generic.Number](values []T) float64 {
+	return Accumulate(values).SampleVariance()
+}
+
+// StdDev returns the population standard deviation of the values, NaN if there are none
+func StdDev[T generic.Number](values []T) float64 {
+	return math.Sqrt(Variance(values))
+}
+
+// SampleStdDev returns the sample standard deviation of the values, NaN if there are less than two
+func SampleStdDev[T generic.Number](values []T) float64 {
+	return math.Sqrt(SampleVariance(values))
+}
+
+// Quantile returns the q-quantile of the values where q is between 0 and 1, NaN if there are no values.
+// Values between two data points are interpolated linearly unless WithInterpolation says otherwise
+func Quantile[T generic.Number](values []T, q float64, opts ...QuantileOption) float64 {
+	if q < 0 || q > 1 || math.IsNaN(q) {
+		panic("Invalid quantile " + strconv.FormatFloat(q, 'g', -1, 64) + ", it must be between 0 and 1")
+	}
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	config := quantileConfig{interpolation: Linear}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	sorted := make([]float64, len(values))
+	for i, value := range values {
+		sorted[i] = float64(value)
+	}
+	slices.Sort(sorted)
+	position := q * float64(len(sorted)-1)
+	lower, higher := int(math.Floor(position)), int(math.Ceil(position))
+	fraction := position - float64(lower)
+	switch config.interpolation {
+	case Lower:
+		return sorted[lower]
+	case Higher:
+		return sorted[higher]
+	case Nearest:
+		return sorted[int(math.RoundToEven(position))]
+	case Midpoint:
+		return (sorted[lower] + sorted[higher]) / 2
+	}
+	return sorted[lower] + (sorted[higher]-sorted[lower])*fraction
+}
+
+// Percentile returns the p-th percentile of the values where p is between 0 and 100, see Quantile
+func Percentile[T generic.Number](values []T, p float64, opts ...QuantileOption) float64 {
+	if p < 0 || p > 100 || math.IsNaN(p) {
+		panic("Invalid percentile " + strconv.FormatFloat(p, 'g', -1, 64) + ", it must be between 0 and 100")
+	}
+	return Quantile(values, p/100, opts...)
+}
+
+// Histogram divides the range of the values into bins buckets of equal width and counts the values in each of them.
+// NaN and infinite values are skipped
+func Histogram[T generic.Number](values []T, bins int) []Bucket {
+	if bins <= 0 {
+		panic("Invalid number of bins " + strconv.Itoa(bins) + ", it must be greater than 0")
+	}
+	finite := make([]float64, 0, len(values))
+	for _, value := range values {
+		if number := float64(value); !math.IsNaN(number) && !math.IsInf(number, 0) {
+			finite = append(finite, number)
+		}
+	}
+	if len(finite) == 0 {
+		return []Bucket{}
+	}
+	low, high := slices.Min(finite), slices.Max(finite)
+	width := (high - low) / float64(bins)
+	buckets := make([]Bucket, bins)
+	for i := range buckets {
+		buckets[i] = Bucket{Min: low + width*float64(i), Max: low + width*float64(i+1)}
+	}
+	buckets[bins-1].Max = high
+	for _, value := range finite {
+		index := bins - 1
+		if width > 0 {
+			index = max(0, min(int((value-low)/width), bins-1))
+		}
+		buckets[index].Count++
+	}
+	return buckets
+}
@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+var values = []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+func closeTo(a float64, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func Test_Sum(t *testing.T) {
+	if sum := Sum(values); sum != 40 {
+		t.Errorf("Sum() = %v, want %v", sum, 40)
+	}
+	if sum := Sum([]float32{0.5, 1.5}); sum != 2 {
+		t.Errorf("Sum() = %v, want %v", sum, 2)
+	}
+}
+
+func Test_Mean(t *testing.T) {
+	if mean := Mean(values); mean != 5 {
+		t.Errorf("Mean() = %v, want %v", mean, 5)
+	}
+	if mean := Mean([]int{}); !math.IsNaN(mean) {
+		t.Errorf("Mean() = %v, want %v", mean, math.NaN())
+	}
+}
+
+func Test_Median(t *testing.T) {
+	if median := Median(values); median != 4.5 {
+		t.Errorf("Median() = %v, want %v", median, 4.5)
+	}
+	if median := Median([]uint8{9, 1, 5}); median != 5 {
+		t.Errorf("Median() = %v, want %v", median, 5)
+	}
+}
+
+func Test_Mode(t *testing.T) {
+	if mode := Mode(values); !reflect.DeepEqual(mode, []int{4}) {
+		t.Errorf("Mode() = %v, want %v", mode, []int{4})
+	}
+	if mode := Mode([]float64{3, 1, 1, 3, 2}); !reflect.DeepEqual(mode, []float64{3, 1}) {
+		t.Errorf("Mode() = %v, want %v", mode, []float64{3, 1})
+	}
+}
+
+func Test_Variance(t *testing.T) {
+	if variance := Variance(values); !closeTo(variance, 4) {
+		t.Errorf("Variance() = %v, want %v", variance, 4)
+	}
+	if deviation := StdDev(values); !closeTo(deviation, 2) {
+		t.Errorf("StdDev() = %v, want %v", deviation, 2)
+	}
+	if variance := SampleVariance(values); !closeTo(variance, 32.0/7) {
+		t.Errorf("SampleVariance() = %v, want %v", variance, 32.0/7)
+	}
+	if deviation := SampleStdDev([]int{1}); !math.IsNaN(deviation) {
+		t.Errorf("SampleStdDev() = %v, want %v", deviation, math.NaN())
+	}
+}
+
+func Test_Quantile(t *testing.T) {
+	data := []int{1, 2, 3, 4}
+	tests := []struct {
+		interpolation Interpolation
+		want          float64
+	}{
+		{Linear, 1.75},
+		{Lower, 1},
+		{Higher, 2},
+		{Nearest, 2},
+		{Midpoint, 1.5},
+	}
+	for _, tt := range tests {
+		if got := Quantile(data, 0.25, WithInterpolation(tt.interpolation)); !closeTo(got, tt.want) {
+			t.Errorf("Quantile(%v) = %v, want %v", tt.interpolation, got, tt.want)
+		}
+	}
+	if got := Percentile(data, 100); got != 4 {
+		t.Errorf("Percentile() = %v, want %v", got, 4)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Quantile() with q > 1 didn't panic")
+		}
+	}()
+	Quantile(data, 1.5)
+}
+
+func Test_Histogram(t *testing.T) {
+	buckets := Histogram([]float64{0, 1, 2, 5, 9, 10}, 2)
+	want := []Bucket{{Min: 0, Max: 5, Count: 3}, {Min: 5, Max: 10, Count: 3}}
+	if !reflect.DeepEqual(buckets, want) {
+		t.Errorf("Histogram() = %v, want %v", buckets, want)
+	}
+	buckets = Histogram([]int{3, 3}, 4)
+	if buckets[3].Count != 2 {
+		t.Errorf("Histogram() = %v, want every value in the last bucket", buckets)
+	}
+	buckets = Histogram([]float64{1, math.Inf(1), 3, math.NaN(), math.Inf(-1)}, 2)
+	want = []Bucket{{Min: 1, Max: 2, Count: 1}, {Min: 2, Max: 3, Count: 1}}
+	if !reflect.DeepEqual(buckets, want) {
+		t.Errorf("Histogram() = %v, want %v", buckets, want)
+	}
+	if buckets = Histogram([]float64{math.NaN(), math.Inf(1)}, 3); len(buckets) != 0 {
+		t.Errorf("Histogram() = %v, want no buckets", buckets)
+	}
+}
@@ -0,0 +1,346 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// DecodeError is returned by FromMap when a value can't be stored in the target, Path uses the syntax of Get
+type DecodeError struct {
+	Path string
+	Err  error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("invalid value at %q: %v", e.Path, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// structField is an exported field of a struct, including the fields promoted from embedded structs
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields returns the fields of a struct type named by their gofp or json tag, the gofp tag takes
+// precedence. Fields of embedded structs without a tag are promoted and shallower fields win over deeper ones
+func structFields(structType reflect.Type) []structField {
+	fields := []structField{}
+	depths := map[string]int{}
+	var collect func(structType reflect.Type, index []int)
+	collect = func(structType reflect.Type, index []int) {
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			name, options, tagged := fieldTag(field)
+			if name == "-" && options == "" && tagged {
+				continue
+			}
+			fieldIndex := append(index[:len(index):len(index)], i)
+			fieldType := field.Type
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if field.Anonymous && !tagged && fieldType.Kind() == reflect.Struct {
+				collect(fieldType, fieldIndex)
+				continue
+			}
+			if !field.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+			if depth, exists := depths[name]; exists && depth <= len(fieldIndex) {
+				continue
+			}
+			depths[name] = len(fieldIndex)
+			for j := range fields {
+				if fields[j].name == name {
+					fields = append(fields[:j], fields[j+1:]...)
+					break
+				}
+			}
+			fields = append(fields, structField{name: name, index: fieldIndex, omitEmpty: strings.Contains(options, "omitempty")})
+		}
+	}
+	collect(structType, nil)
+	return fields
+}
+
+func fieldTag(field reflect.StructField) (name string, options string, tagged bool) {
+	tag, ok := field.Tag.Lookup("gofp")
+	if !ok {
+		tag, ok = field.Tag.Lookup("json")
+	}
+	if !ok {
+		return "", "", false
+	}
+	name, options, _ = strings.Cut(tag, ",")
+	return name, options, name != ""
+}
+
+// ToMap converts a struct, or a pointer to one, to a map keyed by the gofp or json tag or the field name.
+// Nested structs become maps, slices become []interface{} and fields tagged omitempty are left out when empty
+func ToMap(data interface{}) (map[string]interface{}, error) {
+	value := reflect.ValueOf(data)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil, errors.New("ToMap requires a struct, got nil")
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("ToMap requires a struct, got %T", data)
+	}
+	return structToMap(value), nil
+}
+
+func structToMap(value reflect.Value) map[string]interface{} {
+	mapData := map[string]interface{}{}
+	for _, field := range structFields(value.Type()) {
+		fieldValue, ok := fieldByIndex(value, field.index)
+		if !ok || (field.omitEmpty && isEmptyValue(fieldValue)) {
+			continue
+		}
+		mapData[field.name] = toPlainValue(fieldValue)
+	}
+	return mapData
+}
+
+// toPlainValue converts structs to maps and slices to []interface{}, any other value is kept as it is
+func toPlainValue(value reflect.Value) interface{} {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return toPlainValue(value.Elem())
+	case reflect.Struct:
+		if len(structFields(value.Type())) == 0 {
+			return value.Interface()
+		}
+		return structToMap(value)
+	case reflect.Map:
+		if value.IsNil() || value.Type().Key().Kind() != reflect.String {
+			return value.Interface()
+		}
+		mapData := make(map[string]interface{}, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			mapData[iter.Key().String()] = toPlainValue(iter.Value())
+		}
+		return mapData
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return value.Interface()
+		}
+		items := make([]interface{}, value.Len())
+		for i := range items {
+			items[i] = toPlainValue(value.Index(i))
+		}
+		return items
+	}
+	return value.Interface()
+}
+
+// fieldByIndex returns the field at index, ok is false if it's promoted through a nil embedded pointer
+func fieldByIndex(value reflect.Value, index []int) (reflect.Value, bool) {
+	for i, position := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				return reflect.Value{}, false
+			}
+			value = value.Elem()
+		}
+		value = value.Field(position)
+	}
+	return value, true
+}
+
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Struct:
+		return false
+	}
+	return value.IsZero()
+}
+
+// FromMap stores the values of the map in the struct pointed to by target, matching keys the same way
+// ToMap names them. Nested maps fill nested structs, numbers are converted between numeric types
+// when no precision is lost and keys without a matching field are ignored
+func FromMap(mapData map[string]interface{}, target interface{}) error {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("FromMap requires a non-nil pointer to a struct, got %T", target)
+	}
+	return decodeValue(nil, mapData, value.Elem())
+}
+
+func decodeValue(path []string, data interface{}, target reflect.Value) error {
+	fail := func(format string, args ...interface{}) error {
+		return &DecodeError{Path: formatPath(path), Err: fmt.Errorf(format, args...)}
+	}
+	if data == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+	source := reflect.ValueOf(data)
+	if source.Type().AssignableTo(target.Type()) && target.Kind() != reflect.Struct {
+		target.Set(source)
+		return nil
+	}
+	switch target.Kind() {
+	case reflect.Ptr:
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		return decodeValue(path, data, target.Elem())
+	case reflect.Struct:
+		if source.Type() == target.Type() {
+			target.Set(source)
+			return nil
+		}
+		mapData, ok := asStringMap(data)
+		if !ok {
+			return fail("cannot decode %T into %s", data, target.Type())
+		}
+		for _, field := range structFields(target.Type()) {
+			value, exists := mapData[field.name]
+			if !exists {
+				continue
+			}
+			fieldPath := append(path[:len(path):len(path)], field.name)
+			fieldValue, err := allocFieldByIndex(target, field.index)
+			if err != nil {
+				return &DecodeError{Path: formatPath(fieldPath), Err: err}
+			}
+			if err := decodeValue(fieldPath, value, fieldValue); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		mapData, ok := asStringMap(data)
+		if !ok || target.Type().Key().Kind() != reflect.String {
+			return fail("cannot decode %T into %s", data, target.Type())
+		}
+		newMap := reflect.MakeMapWithSize(target.Type(), len(mapData))
+		for key, value := range mapData {
+			item := reflect.New(target.Type().Elem()).Elem()
+			if err := decodeValue(append(path[:len(path):len(path)], key), value, item); err != nil {
+				return err
+			}
+			newMap.SetMapIndex(reflect.ValueOf(key).Convert(target.Type().Key()), item)
+		}
+		target.Set(newMap)
+		return nil
+	case reflect.Slice, reflect.Array:
+		if source.Kind() != reflect.Slice && source.Kind() != reflect.Array {
+			return fail("cannot decode %T into %s", data, target.Type())
+		}
+		items := target
+		if target.Kind() == reflect.Slice {
+			items = reflect.MakeSlice(target.Type(), source.Len(), source.Len())
+		} else if source.Len() > target.Len() {
+			return fail("cannot decode %d elements into %s", source.Len(), target.Type())
+		}
+		for i := 0; i < source.Len(); i++ {
+			if err := decodeValue(append(path[:len(path):len(path)], strconv.Itoa(i)), source.Index(i).Interface(), items.Index(i)); err != nil {
+				return err
+			}
+		}
+		target.Set(items)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, ok := integerValue(source)
+		if !ok || !number.IsInt64() || target.OverflowInt(number.Int64()) {
+			return fail("cannot decode %v into %s", data, target.Type())
+		}
+		target.SetInt(number.Int64())
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number, ok := integerValue(source)
+		if !ok || !number.IsUint64() || target.OverflowUint(number.Uint64()) {
+			return fail("cannot decode %v into %s", data, target.Type())
+		}
+		target.SetUint(number.Uint64())
+		return nil
+	case reflect.Float32, reflect.Float64:
+		number, ok := floatValue(source)
+		if !ok || target.OverflowFloat(number) {
+			return fail("cannot decode %v into %s", data, target.Type())
+		}
+		target.SetFloat(number)
+		return nil
+	}
+	if source.Kind() == target.Kind() && source.Type().ConvertibleTo(target.Type()) {
+		target.Set(source.Convert(target.Type()))
+		return nil
+	}
+	return fail("cannot decode %T into %s", data, target.Type())
+}
+
+// integerValue returns the value of an integer, an integral float or a json.Number holding an integer
+func integerValue(value reflect.Value) (*big.Int, bool) {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(value.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Int).SetUint64(value.Uint()), true
+	}
+	if n, ok := value.Interface().(json.Number); ok {
+		if number, ok := new(big.Int).SetString(n.String(), 10); ok {
+			return number, true
+		}
+	}
+	number, ok := floatValue(value)
+	if !ok || math.IsInf(number, 0) || number != math.Trunc(number) {
+		return nil, false
+	}
+	integer, _ := big.NewFloat(number).Int(nil)
+	return integer, true
+}
+
+// floatValue returns the value of any number or json.Number as float64
+func floatValue(value reflect.Value) (float64, bool) {
+	if n, ok := value.Interface().(json.Number); ok {
+		number, err := n.Float64()
+		return number, err == nil
+	}
+	return toFloat64(value.Interface())
+}
+
+// allocFieldByIndex returns the field at index, allocating nil embedded pointers on the way. Like
+// encoding/json, it fails for a nil pointer to an unexported embedded struct, which can't be set
+func allocFieldByIndex(value reflect.Value, index []int) (reflect.Value, error) {
+	for i, position := range index {
+		if i > 0 && value.Kind() == reflect.Ptr {
+			if value.IsNil() {
+				if !value.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot set embedded pointer to unexported struct %s", value.Type().Elem())
+				}
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(position)
+	}
+	return value, nil
+}
@@ -0,0 +1,154 @@
+package gofp
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type auditInfo struct {
+	CreatedBy string    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+type address struct {
+	Street   string `json:"street"`
+	PostCode string `gofp:"post_code" json:"postCode"`
+}
+
+type customer struct {
+	auditInfo
+	ID        int               `json:"id"`
+	Name      string            `json:"name"`
+	Email     string            `json:"email,omitempty"`
+	Password  string            `json:"-"`
+	Address   *address          `json:"address,omitempty"`
+	Previous  []address         `json:"previous"`
+	Tags      map[string]string `json:"tags,omitempty"`
+	Score     float64
+	Retries   uint8 `json:"retries"`
+	internal  string
+	CreatedBy string `json:"owner"`
+}
+
+func Test_ToMap(t *testing.T) {
+	c := customer{
+		auditInfo: auditInfo{CreatedBy: "admin"},
+		ID:        7,
+		Name:      "John",
+		Password:  "secret",
+		Address:   &address{Street: "10 Downing Street", PostCode: "SW1A"},
+		Previous:  []address{{Street: "Baker Street", PostCode: "NW1"}},
+		Score:     4.5,
+		internal:  "x",
+		CreatedBy: "owner",
+	}
+	got, err := ToMap(&c)
+	want := map[string]interface{}{
+		"created_by": "admin",
+		"created_at": time.Time{},
+		"id":         7,
+		"name":       "John",
+		"address":    map[string]interface{}{"street": "10 Downing Street", "post_code": "SW1A"},
+		"previous":   []interface{}{map[string]interface{}{"street": "Baker Street", "post_code": "NW1"}},
+		"Score":      4.5,
+		"retries":    uint8(0),
+		"owner":      "owner",
+	}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ToMap() = %v, %v, want %v", got, err, want)
+	}
+	if _, err := ToMap(42); err == nil {
+		t.Errorf("ToMap() error = %v, want an error", err)
+	}
+	picked := Pick(got, []string{"name", "id"})
+	if len(picked) != 2 {
+		t.Errorf("Pick(ToMap()) = %v, want %v keys", picked, 2)
+	}
+}
+
+func Test_FromMap(t *testing.T) {
+	var data map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"created_by": "admin",
+		"id": 7,
+		"name": "John",
+		"Password": "ignored",
+		"address": {"street": "10 Downing Street", "post_code": "SW1A"},
+		"previous": [{"street": "Baker Street"}],
+		"tags": {"tier": "gold"},
+		"Score": 4.5,
+		"retries": 3,
+		"owner": "owner"
+	}`), &data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got customer
+	if err := FromMap(data, &got); err != nil {
+		t.Fatalf("FromMap() error = %v", err)
+	}
+	want := customer{
+		auditInfo: auditInfo{CreatedBy: "admin"},
+		ID:        7,
+		Name:      "John",
+		Address:   &address{Street: "10 Downing Street", PostCode: "SW1A"},
+		Previous:  []address{{Street: "Baker Street"}},
+		Tags:      map[string]string{"tier": "gold"},
+		Score:     4.5,
+		Retries:   3,
+		CreatedBy: "owner",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FromMap() = %+v, want %+v", got, want)
+	}
+
+	roundTrip, _ := ToMap(want)
+	var decoded customer
+	if err := FromMap(roundTrip, &decoded); err != nil || !reflect.DeepEqual(decoded, want) {
+		t.Errorf("FromMap(ToMap()) = %+v, %v, want %+v", decoded, err, want)
+	}
+}
+
+func Test_FromMapErrors(t *testing.T) {
+	tests := []struct {
+		data map[string]interface{}
+		path string
+	}{
+		{map[string]interface{}{"id": 7.5}, "id"},
+		{map[string]interface{}{"retries": 300}, "retries"},
+		{map[string]interface{}{"retries": -1}, "retries"},
+		{map[string]interface{}{"name": 10}, "name"},
+		{map[string]interface{}{"previous": []interface{}{map[string]interface{}{"street": true}}}, "previous.0.street"},
+		{map[string]interface{}{"address": "10 Downing Street"}, "address"},
+	}
+	for _, tt := range tests {
+		var target customer
+		err := FromMap(tt.data, &target)
+		var decodeErr *DecodeError
+		if !errors.As(err, &decodeErr) || decodeErr.Path != tt.path {
+			t.Errorf("FromMap(%v) error = %v, want an error at %q", tt.data, err, tt.path)
+		}
+	}
+	type inner struct {
+		V int
+	}
+	type embedded struct {
+		*inner
+		W int
+	}
+	var target embedded
+	var decodeErr *DecodeError
+	if err := FromMap(map[string]interface{}{"V": 1, "W": 2}, &target); !errors.As(err, &decodeErr) || decodeErr.Path != "V" {
+		t.Errorf("FromMap() error = %v, want an error at %q", err, "V")
+	}
+	target = embedded{inner: &inner{}}
+	if err := FromMap(map[string]interface{}{"V": 1, "W": 2}, &target); err != nil || target.V != 1 || target.W != 2 {
+		t.Errorf("FromMap() = %+v, %v, want V and W set", target, err)
+	}
+	if err := FromMap(map[string]interface{}{}, customer{}); err == nil {
+		t.Errorf("FromMap() error = %v, want an error", err)
+	}
+}
@@ -0,0 +1,31 @@
+package gofp
+
+import "github.com/rbrahul/gofp/generic"
+
+// SlidingWindow returns a new slice of windows holding size consecutive items, a new window starts
+// every step items. Trailing items which don't fill a whole window are left out
+func SlidingWindow(items []interface{}, size int, step int) [][]interface{} {
+	return generic.SlidingWindow(items, size, step)
+}
+
+// Partition returns a new slice of items which satisfy the condition and another one with the rest
+func Partition(items []interface{}, fn func(index int, item interface{}) bool) ([]interface{}, []interface{}) {
+	return generic.Partition(items, fn)
+}
+
+// SplitAt returns new slices of the items before the index and from the index on. A negative index
+// counts from the end and out of range indexes are clamped
+func SplitAt(items []interface{}, index int) ([]interface{}, []interface{}) {
+	return generic.SplitAt(items, index)
+}
+
+// SplitWhen splits the items before the first item which satisfies the condition
+func SplitWhen(items []interface{}, fn func(index int, item interface{}) bool) ([]interface{}, []interface{}) {
+	return generic.SplitWhen(items, fn)
+}
+
+// ChunkBy returns a new slice of chunks, every chunk holds a run of consecutive items for which the
+// function returns the same key
+func ChunkBy(items []interface{}, fn func(item interface{}) interface{}) [][]interface{} {
+	return generic.ChunkByFunc(items, fn, equal)
+}
@@ -0,0 +1,70 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_SlidingWindow(t *testing.T) {
+	windows := SlidingWindow([]interface{}{1, 2, 3, 4, 5}, 3, 1)
+	want := [][]interface{}{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}
+	if !reflect.DeepEqual(windows, want) {
+		t.Errorf("SlidingWindow() = %v, want %v", windows, want)
+	}
+	windows = SlidingWindow([]interface{}{1, 2, 3, 4, 5}, 2, 2)
+	if want := [][]interface{}{{1, 2}, {3, 4}}; !reflect.DeepEqual(windows, want) {
+		t.Errorf("SlidingWindow() = %v, want %v", windows, want)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("SlidingWindow() with step 0 didn't panic")
+		}
+	}()
+	SlidingWindow([]interface{}{1}, 1, 0)
+}
+
+func Test_Partition(t *testing.T) {
+	evens, odds := Partition([]interface{}{1, 2, 3, 4, 5}, func(_ int, item interface{}) bool {
+		return item.(int)%2 == 0
+	})
+	if !reflect.DeepEqual(evens, []interface{}{2, 4}) || !reflect.DeepEqual(odds, []interface{}{1, 3, 5}) {
+		t.Errorf("Partition() = %v, %v, want %v, %v", evens, odds, []interface{}{2, 4}, []interface{}{1, 3, 5})
+	}
+}
+
+func Test_SplitAt(t *testing.T) {
+	tests := []struct {
+		index         int
+		before, after []interface{}
+	}{
+		{2, []interface{}{1, 2}, []interface{}{3}},
+		{-1, []interface{}{1, 2}, []interface{}{3}},
+		{10, []interface{}{1, 2, 3}, []interface{}{}},
+		{-10, []interface{}{}, []interface{}{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		before, after := SplitAt([]interface{}{1, 2, 3}, tt.index)
+		if !reflect.DeepEqual(before, tt.before) || !reflect.DeepEqual(after, tt.after) {
+			t.Errorf("SplitAt(%d) = %v, %v, want %v, %v", tt.index, before, after, tt.before, tt.after)
+		}
+	}
+}
+
+func Test_SplitWhen(t *testing.T) {
+	before, after := SplitWhen([]interface{}{1, 2, 10, 3}, func(_ int, item interface{}) bool {
+		return item.(int) > 5
+	})
+	if !reflect.DeepEqual(before, []interface{}{1, 2}) || !reflect.DeepEqual(after, []interface{}{10, 3}) {
+		t.Errorf("SplitWhen() = %v, %v, want %v, %v", before, after, []interface{}{1, 2}, []interface{}{10, 3})
+	}
+}
+
+func Test_ChunkBy(t *testing.T) {
+	chunks := ChunkBy([]interface{}{1, 3, 2, 4, 5, 6}, func(item interface{}) interface{} {
+		return item.(int) % 2
+	})
+	want := [][]interface{}{{1, 3}, {2, 4}, {5}, {6}}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("ChunkBy() = %v, want %v", chunks, want)
+	}
+}
@@ -0,0 +1,44 @@
+package gofp
+
+import "github.com/rbrahul/gofp/generic"
+
+// Zip returns a new slice of tuples holding the items at the same position of every slice, it stops at the shortest slice
+func Zip(slices ...[]interface{}) [][]interface{} {
+	return generic.ZipN(slices...)
+}
+
+// ZipLongest works like Zip but continues until the longest slice ends, the missing items are replaced by fill
+func ZipLongest(fill interface{}, slices ...[]interface{}) [][]interface{} {
+	return generic.ZipNLongest(fill, slices...)
+}
+
+// ZipWith returns a new slice of the values returned from the function called with the items at the same
+// position of every slice, it stops at the shortest slice
+func ZipWith(fn func(items ...interface{}) interface{}, slices ...[]interface{}) []interface{} {
+	zipped := Zip(slices...)
+	results := make([]interface{}, len(zipped))
+	for i, tuple := range zipped {
+		results[i] = fn(tuple...)
+	}
+	return results
+}
+
+// Unzip is the inverse of Zip, it returns one slice per position of the tuples
+func Unzip(tuples [][]interface{}) [][]interface{} {
+	return Transpose(tuples)
+}
+
+// Transpose returns a new slice of slices whose rows are the columns of rows. Items missing from shorter rows are skipped
+func Transpose(rows [][]interface{}) [][]interface{} {
+	return generic.Transpose(rows)
+}
+
+// CartesianProduct returns a new slice of every combination taking one item of each slice, the last slice varies fastest
+func CartesianProduct(slices ...[]interface{}) [][]interface{} {
+	return generic.CartesianProduct(slices...)
+}
+
+// Interleave returns a new slice taking one item of every slice in turn, skipping the slices which ran out of items
+func Interleave(slices ...[]interface{}) []interface{} {
+	return generic.Interleave(slices...)
+}
@@ -0,0 +1,69 @@
+package gofp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_Zip(t *testing.T) {
+	zipped := Zip([]interface{}{1, 2, 3}, []interface{}{"a", "b"}, []interface{}{true, false, true})
+	want := [][]interface{}{{1, "a", true}, {2, "b", false}}
+	if !reflect.DeepEqual(zipped, want) {
+		t.Errorf("Zip() = %v, want %v", zipped, want)
+	}
+	if zipped := Zip(); len(zipped) != 0 {
+		t.Errorf("Zip() = %v, want %v", zipped, [][]interface{}{})
+	}
+}
+
+func Test_ZipLongest(t *testing.T) {
+	zipped := ZipLongest(0, []interface{}{1, 2, 3}, []interface{}{"a"})
+	want := [][]interface{}{{1, "a"}, {2, 0}, {3, 0}}
+	if !reflect.DeepEqual(zipped, want) {
+		t.Errorf("ZipLongest() = %v, want %v", zipped, want)
+	}
+}
+
+func Test_ZipWith(t *testing.T) {
+	sums := ZipWith(func(items ...interface{}) interface{} {
+		return items[0].(int) + items[1].(int)
+	}, []interface{}{1, 2, 3}, []interface{}{10, 20})
+	if want := []interface{}{11, 22}; !reflect.DeepEqual(sums, want) {
+		t.Errorf("ZipWith() = %v, want %v", sums, want)
+	}
+}
+
+func Test_Unzip(t *testing.T) {
+	unzipped := Unzip([][]interface{}{{1, "a"}, {2, "b"}})
+	want := [][]interface{}{{1, 2}, {"a", "b"}}
+	if !reflect.DeepEqual(unzipped, want) {
+		t.Errorf("Unzip() = %v, want %v", unzipped, want)
+	}
+}
+
+func Test_Transpose(t *testing.T) {
+	transposed := Transpose([][]interface{}{{1, 2, 3}, {4, 5}, {6}})
+	want := [][]interface{}{{1, 4, 6}, {2, 5}, {3}}
+	if !reflect.DeepEqual(transposed, want) {
+		t.Errorf("Transpose() = %v, want %v", transposed, want)
+	}
+}
+
+func Test_CartesianProduct(t *testing.T) {
+	product := CartesianProduct([]interface{}{1, 2}, []interface{}{"a", "b"})
+	want := [][]interface{}{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}}
+	if !reflect.DeepEqual(product, want) {
+		t.Errorf("CartesianProduct() = %v, want %v", product, want)
+	}
+	if product := CartesianProduct([]interface{}{1}, []interface{}{}); len(product) != 0 {
+		t.Errorf("CartesianProduct() = %v, want %v", product, [][]interface{}{})
+	}
+}
+
+func Test_Interleave(t *testing.T) {
+	interleaved := Interleave([]interface{}{1, 2, 3}, []interface{}{"a"}, []interface{}{true, false})
+	want := []interface{}{1, "a", true, 2, false, 3}
+	if !reflect.DeepEqual(interleaved, want) {
+		t.Errorf("Interleave() = %v, want %v", interleaved, want)
+	}
+}